@@ -0,0 +1,116 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"sort"
+	"sync"
+	"time"
+)
+
+// An AlbumAggregator collects messages that belong to a media group into albums.
+// Telegram delivers every message of an album as a separate update. The aggregator buffers these messages and puts
+// an album into the Albums channel once no new message for its media group has arrived for the aggregation window.
+type AlbumAggregator struct {
+	Albums  chan *model.Album // a channel providing completed albums
+	window  time.Duration
+	pending map[string]*pendingAlbum
+	closed  bool
+	done    chan struct{} // closed by Close, to abort albums being put into the Albums channel
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+}
+
+type pendingAlbum struct {
+	album      model.Album
+	timer      *time.Timer
+	generation int // incremented when the timer is replaced, to ignore a timer that fired too late to be stopped
+}
+
+// NewAlbumAggregator creates a new AlbumAggregator that waits for window after the latest message of an album
+// before emitting it. One second is usually enough.
+func NewAlbumAggregator(window time.Duration) *AlbumAggregator {
+	return &AlbumAggregator{
+		Albums:  make(chan *model.Album),
+		window:  window,
+		pending: map[string]*pendingAlbum{},
+		done:    make(chan struct{}),
+	}
+}
+
+// Add adds a message to the aggregator.
+// If the message does not belong to a media group, or the aggregator was closed, false is returned and the message
+// should be handled by the caller.
+func (a *AlbumAggregator) Add(m model.Message) bool {
+	if m.MediaGroupID == nil {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.closed {
+		return false
+	}
+
+	a.add(*m.MediaGroupID, m)
+	return true
+}
+
+// add adds the message to the pending album and restarts its timer, the caller must hold mu
+func (a *AlbumAggregator) add(id string, m model.Message) {
+	p, ok := a.pending[id]
+	if !ok {
+		p = &pendingAlbum{album: model.Album{MediaGroupID: id}}
+		a.pending[id] = p
+	} else if !p.timer.Stop() {
+		// the timer fired, but emit is still waiting for mu, it must not emit the album before the new window ends
+		p.generation++
+	}
+	generation := p.generation
+	p.timer = time.AfterFunc(a.window, func() { a.emit(id, generation) })
+	p.album.Messages = append(p.album.Messages, m)
+}
+
+func (a *AlbumAggregator) emit(id string, generation int) {
+	a.mu.Lock()
+	p, ok := a.pending[id]
+	if !ok || a.closed || p.generation != generation {
+		a.mu.Unlock()
+		return
+	}
+	delete(a.pending, id)
+	a.wg.Add(1)
+	a.mu.Unlock()
+
+	sort.Sort(byMessageID(p.album.Messages))
+	select {
+	case a.Albums <- &p.album:
+	case <-a.done:
+	}
+	a.wg.Done()
+}
+
+// Close shuts down the aggregator and closes the Albums channel.
+// Albums that are still being collected or not yet received from the Albums channel are discarded.
+func (a *AlbumAggregator) Close() {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return
+	}
+	a.closed = true
+	for id, p := range a.pending {
+		p.timer.Stop()
+		delete(a.pending, id)
+	}
+	close(a.done)
+	a.mu.Unlock()
+
+	a.wg.Wait()
+	close(a.Albums)
+}
+
+type byMessageID []model.Message
+
+func (a byMessageID) Len() int           { return len(a) }
+func (a byMessageID) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byMessageID) Less(i, j int) bool { return a[i].ID < a[j].ID }
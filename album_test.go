@@ -0,0 +1,99 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"testing"
+	"time"
+)
+
+func albumMessage(id int, mediaGroupID string) model.Message {
+	m := model.Message{}
+	m.ID = id
+	m.MediaGroupID = &mediaGroupID
+	return m
+}
+
+func TestAlbumAggregator(t *testing.T) {
+	a := NewAlbumAggregator(10 * time.Millisecond)
+	defer a.Close()
+
+	if a.Add(model.Message{}) {
+		t.Error("message without media group was added")
+	}
+	a.Add(albumMessage(2, "g"))
+	a.Add(albumMessage(1, "g"))
+
+	select {
+	case album := <-a.Albums:
+		if album.MediaGroupID != "g" || len(album.Messages) != 2 || album.Messages[0].ID != 1 {
+			t.Errorf("got %+v", album)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("album was not emitted")
+	}
+}
+
+func TestAlbumAggregatorCloseWithoutReader(t *testing.T) {
+	a := NewAlbumAggregator(time.Millisecond)
+	a.Add(albumMessage(1, "g"))
+	time.Sleep(20 * time.Millisecond) // the album is now being put into the channel
+
+	closed := make(chan struct{})
+	go func() {
+		a.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close blocked on an album nobody receives")
+	}
+	if _, ok := <-a.Albums; ok {
+		t.Error("Albums was not closed")
+	}
+}
+
+func TestAlbumAggregatorAddAfterClose(t *testing.T) {
+	a := NewAlbumAggregator(time.Millisecond)
+	a.Close()
+
+	if a.Add(albumMessage(1, "g")) {
+		t.Error("message was accepted after Close")
+	}
+}
+
+// TestAlbumAggregatorWindowRace adds a part while the timer of its album fires, so that emit already waits for the
+// lock. The album must only be emitted once the window after that part ended.
+func TestAlbumAggregatorWindowRace(t *testing.T) {
+	const window = 50 * time.Millisecond
+	a := NewAlbumAggregator(window)
+	defer a.Close()
+
+	a.Add(albumMessage(1, "g"))
+
+	a.mu.Lock()
+	time.Sleep(2 * window) // the timer fires and emit blocks on the lock
+	a.add("g", albumMessage(2, "g"))
+	a.mu.Unlock()
+
+	time.Sleep(window / 5)
+	if !a.Add(albumMessage(3, "g")) {
+		t.Fatal("part was not added")
+	}
+
+	select {
+	case album := <-a.Albums:
+		if len(album.Messages) != 3 {
+			t.Errorf("album was emitted with %d of 3 parts", len(album.Messages))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("album was not emitted")
+	}
+
+	select {
+	case album := <-a.Albums:
+		t.Errorf("album was split, got second album with %d parts", len(album.Messages))
+	case <-time.After(2 * window):
+	}
+}
@@ -2,6 +2,7 @@ package tbotapi
 
 import (
 	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -10,29 +11,47 @@ import (
 // A TelegramBotAPI is an API Client for one Telegram bot.
 // Create a new client by calling the New() function.
 type TelegramBotAPI struct {
-	ID       int                // the bots ID
+	ID       int64              // the bots ID
 	Name     string             // the bots Name as seen by users
 	Username string             // the bots username
 	Updates  chan *model.Update // a channel providing updates this bot receives
 	Errors   chan error         // a channel providing errors that occur during the retrieval of updates
+
+	// FollowMigrations can be set to make send methods retry once against the new supergroup if the
+	// target group was migrated. Note that this overwrites the Recipient of the outgoing message passed by the
+	// caller with the supergroup, so sending the same outgoing message again addresses the supergroup directly.
+	FollowMigrations bool
+
 	baseURIs map[method]string
 	closed   chan struct{}
 	c        *client
 	wg       sync.WaitGroup
+
+	allowedUpdates string // JSON array of update kinds, empty for the API default
+	allowedMu      sync.Mutex
 }
 
-const apiBaseURI string = "https://api.telegram.org/bot%s"
+// DefaultServerURI is the URI of the official Telegram Bot API server
+const DefaultServerURI string = "https://api.telegram.org"
+
+const apiBaseURI string = "%s/bot%s"
 
 // New creates a new API Client for a Telegram bot using the apiKey provided.
 // It will call the GetMe method to retrieve the bots id, name and username.
 // Additionally, an update loop is started, pumping updates into the Updates channel.
 func New(apiKey string) (*TelegramBotAPI, error) {
+	return NewWithServer(apiKey, DefaultServerURI)
+}
+
+// NewWithServer creates a new API Client like New, but uses the Bot API server at serverURI instead of the official
+// one. This is useful for self-hosted Bot API servers or fake servers in tests.
+func NewWithServer(apiKey, serverURI string) (*TelegramBotAPI, error) {
 	toReturn := TelegramBotAPI{
 		Updates:  make(chan *model.Update),
 		Errors:   make(chan error),
-		baseURIs: createEndpoints(fmt.Sprintf(apiBaseURI, apiKey)),
+		baseURIs: createEndpoints(fmt.Sprintf(apiBaseURI, serverURI, apiKey)),
 		closed:   make(chan struct{}),
-		c:        newClient(fmt.Sprintf(apiBaseURI, apiKey)),
+		c:        newClient(fmt.Sprintf(apiBaseURI, serverURI, apiKey)),
 	}
 	user, err := toReturn.GetMe()
 	if err != nil {
@@ -96,6 +115,40 @@ func (api *TelegramBotAPI) updateLoop() {
 	}
 }
 
+// SetAllowedUpdates sets the kinds of updates the bot receives.
+// By default, all kinds except ChatMemberUpdate, MessageReactionUpdate and MessageReactionCountUpdate are received.
+// Calling this without arguments restores the default, use model.AllUpdateKinds to receive all kinds.
+// The change takes effect with the next request for updates, which may be up to one minute later.
+func (api *TelegramBotAPI) SetAllowedUpdates(kinds ...model.UpdateKind) {
+	allowed := ""
+	if len(kinds) > 0 {
+		names := []string{}
+		for _, kind := range kinds {
+			names = append(names, kind.String())
+		}
+		b, err := json.Marshal(names)
+		if err != nil {
+			panic(err)
+		}
+		allowed = string(b)
+	}
+
+	api.allowedMu.Lock()
+	defer api.allowedMu.Unlock()
+	api.allowedUpdates = allowed
+}
+
+func (api *TelegramBotAPI) updateQuerystring() map[string]string {
+	toReturn := map[string]string{"timeout": fmt.Sprint(60)}
+
+	api.allowedMu.Lock()
+	defer api.allowedMu.Unlock()
+	if api.allowedUpdates != "" {
+		toReturn["allowed_updates"] = api.allowedUpdates
+	}
+	return toReturn
+}
+
 func putUpdatesInChannel(channel chan *model.Update, updates []model.Update) int {
 	highestOffset := -1
 	for _, update := range updates {
@@ -108,7 +161,7 @@ func putUpdatesInChannel(channel chan *model.Update, updates []model.Update) int
 
 func (api *TelegramBotAPI) getUpdates() (*model.UpdateResponse, error) {
 	resp := &model.UpdateResponse{}
-	response, err := api.c.getQuerystring(getUpdates, resp, map[string]string{"timeout": fmt.Sprint(60)})
+	response, err := api.c.getQuerystring(getUpdates, resp, api.updateQuerystring())
 
 	if err != nil {
 		if response != nil {
@@ -130,10 +183,9 @@ func (api *TelegramBotAPI) getUpdates() (*model.UpdateResponse, error) {
 
 func (api *TelegramBotAPI) getUpdatesByOffset(offset int) (*model.UpdateResponse, error) {
 	resp := &model.UpdateResponse{}
-	response, err := api.c.getQuerystring(getUpdates, resp, map[string]string{
-		"timeout": fmt.Sprint(60),
-		"offset":  fmt.Sprint(offset),
-	})
+	querystring := api.updateQuerystring()
+	querystring["offset"] = fmt.Sprint(offset)
+	response, err := api.c.getQuerystring(getUpdates, resp, querystring)
 
 	if err != nil {
 		if response != nil {
@@ -188,7 +240,7 @@ func (api *TelegramBotAPI) GetFile(fileID string) (*model.FileResponse, error) {
 // SendMessage sends a text message to the chatID specified, with the given text.
 // For more options, use the SendMessageExtended function.
 // On success, the sent message is returned as a MessageResponse.
-func (api *TelegramBotAPI) SendMessage(chatID int, text string) (*model.MessageResponse, error) {
+func (api *TelegramBotAPI) SendMessage(chatID int64, text string) (*model.MessageResponse, error) {
 	return api.SendMessageExtended(model.NewOutgoingMessage(model.NewChatRecipient(chatID), text))
 }
 
@@ -202,6 +254,9 @@ func (api *TelegramBotAPI) SendMessageExtended(om *model.OutgoingMessage) (*mode
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &om.Recipient) {
+		return api.SendMessageExtended(om)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -209,6 +264,23 @@ func (api *TelegramBotAPI) SendMessageExtended(om *model.OutgoingMessage) (*mode
 	return resp, nil
 }
 
+// SendLongMessage sends a text message that may exceed the maximum message length.
+// The text is split on paragraph, line and word boundaries and the parts are sent in order, see
+// OutgoingMessage.Split. Only the last part carries the reply markup.
+// On success, the sent messages are returned as MessageResponses.
+// On failure, the responses for the parts sent so far are returned along with the error.
+func (api *TelegramBotAPI) SendLongMessage(om *model.OutgoingMessage) ([]*model.MessageResponse, error) {
+	var toReturn []*model.MessageResponse
+	for _, part := range om.Split(model.MaxMessageLength) {
+		resp, err := api.SendMessageExtended(part)
+		if err != nil {
+			return toReturn, err
+		}
+		toReturn = append(toReturn, resp)
+	}
+	return toReturn, nil
+}
+
 // ForwardMessage forwards a message with ID messageID from the fromChatID to the toChatID chat.
 // On success, the sent message is returned as a MessageResponse.
 func (api *TelegramBotAPI) ForwardMessage(of *model.OutgoingForward) (*model.MessageResponse, error) {
@@ -218,6 +290,71 @@ func (api *TelegramBotAPI) ForwardMessage(of *model.OutgoingForward) (*model.Mes
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &of.Recipient) {
+		return api.ForwardMessage(of)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CopyMessage copies a message without a link to the original message.
+// Use NewOutgoingCopy to construct the copy.
+// On success, the ID of the sent message is returned as a MessageIDResponse.
+func (api *TelegramBotAPI) CopyMessage(oc *model.OutgoingCopy) (*model.MessageIDResponse, error) {
+	resp := &model.MessageIDResponse{}
+	_, err := api.c.postJSON(copyMessage, resp, oc)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &oc.Recipient) {
+		return api.CopyMessage(oc)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ForwardMessages forwards multiple messages at once.
+// Messages that cannot be found or forwarded are skipped.
+// Use NewOutgoingForwards to construct the forwards.
+// On success, the IDs of the sent messages are returned as a MessageIDsResponse.
+func (api *TelegramBotAPI) ForwardMessages(of *model.OutgoingForwards) (*model.MessageIDsResponse, error) {
+	resp := &model.MessageIDsResponse{}
+	_, err := api.c.postJSON(forwardMessages, resp, of)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &of.Recipient) {
+		return api.ForwardMessages(of)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CopyMessages copies multiple messages at once without links to the original messages.
+// Messages that cannot be found or copied are skipped.
+// Use NewOutgoingCopies to construct the copies.
+// On success, the IDs of the sent messages are returned as a MessageIDsResponse.
+func (api *TelegramBotAPI) CopyMessages(oc *model.OutgoingCopies) (*model.MessageIDsResponse, error) {
+	resp := &model.MessageIDsResponse{}
+	_, err := api.c.postJSON(copyMessages, resp, oc)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &oc.Recipient) {
+		return api.CopyMessages(oc)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -242,6 +379,9 @@ func (api *TelegramBotAPI) ResendPhoto(op *model.OutgoingPhoto, fileID string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &op.Recipient) {
+		return api.ResendPhoto(op, fileID)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -260,6 +400,9 @@ func (api *TelegramBotAPI) SendPhoto(op *model.OutgoingPhoto, filePath string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &op.Recipient) {
+		return api.SendPhoto(op, filePath)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -284,6 +427,9 @@ func (api *TelegramBotAPI) ResendVoice(ov *model.OutgoingVoice, fileID string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &ov.Recipient) {
+		return api.ResendVoice(ov, fileID)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -303,6 +449,9 @@ func (api *TelegramBotAPI) SendVoice(ov *model.OutgoingVoice, filePath string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &ov.Recipient) {
+		return api.SendVoice(ov, filePath)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -327,6 +476,9 @@ func (api *TelegramBotAPI) ResendAudio(oa *model.OutgoingAudio, fileID string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &oa.Recipient) {
+		return api.ResendAudio(oa, fileID)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -346,6 +498,9 @@ func (api *TelegramBotAPI) SendAudio(oa *model.OutgoingAudio, filePath string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &oa.Recipient) {
+		return api.SendAudio(oa, filePath)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -370,6 +525,9 @@ func (api *TelegramBotAPI) ResendDocument(od *model.OutgoingDocument, fileID str
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &od.Recipient) {
+		return api.ResendDocument(od, fileID)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -388,6 +546,9 @@ func (api *TelegramBotAPI) SendDocument(od *model.OutgoingDocument, filePath str
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &od.Recipient) {
+		return api.SendDocument(od, filePath)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -412,6 +573,9 @@ func (api *TelegramBotAPI) ResendSticker(os *model.OutgoingSticker, fileID strin
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &os.Recipient) {
+		return api.ResendSticker(os, fileID)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -431,6 +595,9 @@ func (api *TelegramBotAPI) SendSticker(os *model.OutgoingSticker, filePath strin
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &os.Recipient) {
+		return api.SendSticker(os, filePath)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -455,6 +622,9 @@ func (api *TelegramBotAPI) ResendVideo(ov *model.OutgoingVideo, fileID string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &ov.Recipient) {
+		return api.ResendVideo(ov, fileID)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -474,6 +644,9 @@ func (api *TelegramBotAPI) SendVideo(ov *model.OutgoingVideo, filePath string) (
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &ov.Recipient) {
+		return api.SendVideo(ov, filePath)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -491,6 +664,9 @@ func (api *TelegramBotAPI) SendLocation(ol *model.OutgoingLocation) (*model.Mess
 	if err != nil {
 		return nil, err
 	}
+	if api.followMigration(&resp.BaseResponse, &ol.Recipient) {
+		return api.SendLocation(ol)
+	}
 	err = check(&resp.BaseResponse)
 	if err != nil {
 		return nil, err
@@ -498,21 +674,217 @@ func (api *TelegramBotAPI) SendLocation(ol *model.OutgoingLocation) (*model.Mess
 	return resp, nil
 }
 
-// SendChatAction sends a chat action to the specified chatID.
-// Use the ChatAction constants to specify the action.
-// On success, a BaseResponse is returned.
-func (api *TelegramBotAPI) SendChatAction(recipient model.Recipient, action model.ChatAction) (*model.BaseResponse, error) {
-	resp := &model.BaseResponse{}
+// SendMediaGroup sends a group of two to ten photos, videos or documents as an album.
+// Use NewOutgoingMediaGroup to construct the message. Files already on the Telegram servers and files to upload
+// can be mixed freely, see NewInputMedia and NewInputMediaUpload.
+// On success, the sent messages are returned as a MessagesResponse.
+func (api *TelegramBotAPI) SendMediaGroup(og *model.OutgoingMediaGroup) (*model.MessagesResponse, error) {
+	if len(og.Media) < 2 || len(og.Media) > 10 {
+		return nil, fmt.Errorf("tbotapi: media groups must contain 2 to 10 items, got %d", len(og.Media))
+	}
+
+	resp := &model.MessagesResponse{}
+	_, err := api.c.uploadFiles(sendMediaGroup, resp, filesFrom(og.GetFiles()), og)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &og.Recipient) {
+		return api.SendMediaGroup(og)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendContact sends a phone contact.
+// Use NewOutgoingContact to construct the message to send.
+// On success, the sent message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SendContact(oc *model.OutgoingContact) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(sendContact, resp, oc)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &oc.Recipient) {
+		return api.SendContact(oc)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendVenue sends information about a venue.
+// Use NewOutgoingVenue to construct the message to send.
+// On success, the sent message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SendVenue(ov *model.OutgoingVenue) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(sendVenue, resp, ov)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &ov.Recipient) {
+		return api.SendVenue(ov)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendDice sends an animated emoji that displays a random value.
+// Use NewOutgoingDice to construct the message to send.
+// On success, the sent message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SendDice(od *model.OutgoingDice) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(sendDice, resp, od)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &od.Recipient) {
+		return api.SendDice(od)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EditMessageLiveLocation updates a live location message sent by the bot.
+// Use NewOutgoingLiveLocationEdit to construct the update.
+// On success, the edited message is returned as a MessageResponse.
+func (api *TelegramBotAPI) EditMessageLiveLocation(oe *model.OutgoingLiveLocationEdit) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(editMessageLiveLocation, resp, oe)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StopMessageLiveLocation stops updating a live location message sent by the bot before its live period expires.
+// On success, the edited message is returned as a MessageResponse.
+func (api *TelegramBotAPI) StopMessageLiveLocation(recipient model.Recipient, messageID int) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
 	toSend := struct {
-		model.OutgoingBase
-		Action string `json:"action"`
+		Recipient model.Recipient `json:"chat_id"`
+		MessageID int             `json:"message_id"`
 	}{
-		OutgoingBase: model.OutgoingBase{
-			Recipient: recipient,
-		},
-		Action: string(action),
+		Recipient: recipient,
+		MessageID: messageID,
 	}
-	_, err := api.c.postJSON(sendChatAction, resp, toSend)
+	_, err := api.c.postJSON(stopMessageLiveLocation, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendPoll sends a poll or quiz.
+// Use NewOutgoingPoll or NewOutgoingQuiz to construct the poll.
+// On success, the sent message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SendPoll(op *model.OutgoingPoll) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(sendPoll, resp, op)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &op.Recipient) {
+		return api.SendPoll(op)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StopPoll stops a poll sent by the bot.
+// On success, the stopped poll is returned as a PollResponse.
+func (api *TelegramBotAPI) StopPoll(recipient model.Recipient, messageID int) (*model.PollResponse, error) {
+	resp := &model.PollResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+		MessageID int             `json:"message_id"`
+	}{
+		Recipient: recipient,
+		MessageID: messageID,
+	}
+	_, err := api.c.postJSON(stopPoll, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendInvoice sends an invoice.
+// Use NewInvoiceDetails and NewOutgoingInvoice to construct the invoice.
+// On success, the sent message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SendInvoice(oi *model.OutgoingInvoice) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(sendInvoice, resp, oi)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &oi.Recipient) {
+		return api.SendInvoice(oi)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateInvoiceLink creates a link for an invoice.
+// Use NewInvoiceDetails to construct the invoice.
+// On success, the link is returned as an InvoiceLinkResponse.
+func (api *TelegramBotAPI) CreateInvoiceLink(iv *model.InvoiceDetails) (*model.InvoiceLinkResponse, error) {
+	resp := &model.InvoiceLinkResponse{}
+	_, err := api.c.postJSON(createInvoiceLink, resp, iv)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AnswerShippingQuery answers a ShippingQuery, which is sent for flexible invoices.
+// Use NewOutgoingShippingQueryAnswer or NewOutgoingShippingQueryError to construct the answer.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) AnswerShippingQuery(oa *model.OutgoingShippingQueryAnswer) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.postJSON(answerShippingQuery, resp, oa)
 
 	if err != nil {
 		return nil, err
@@ -524,12 +896,29 @@ func (api *TelegramBotAPI) SendChatAction(recipient model.Recipient, action mode
 	return resp, nil
 }
 
-// GetProfilePhotos gets a users profile pictures.
-// Use NewOutgoingUserProfilePhotosRequest to create the request.
-// On success, the photos are returned as a UserProfilePhotosResponse.
-func (api *TelegramBotAPI) GetProfilePhotos(op *model.OutgoingUserProfilePhotosRequest) (*model.UserProfilePhotosResponse, error) {
-	resp := &model.UserProfilePhotosResponse{}
-	_, err := api.c.postJSON(getUserProfilePhotos, resp, op)
+// AnswerPreCheckoutQuery answers a PreCheckoutQuery, which must be done within ten seconds of receiving it.
+// Use NewOutgoingPreCheckoutQueryAnswer or NewOutgoingPreCheckoutQueryError to construct the answer.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) AnswerPreCheckoutQuery(oa *model.OutgoingPreCheckoutQueryAnswer) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.postJSON(answerPreCheckoutQuery, resp, oa)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetStarTransactions gets the bots Telegram Star transactions, in chronological order.
+// Use NewOutgoingStarTransactionsRequest to create the request, page through the transactions using its offset.
+// On success, the transactions are returned as a StarTransactionsResponse.
+func (api *TelegramBotAPI) GetStarTransactions(or *model.OutgoingStarTransactionsRequest) (*model.StarTransactionsResponse, error) {
+	resp := &model.StarTransactionsResponse{}
+	_, err := api.c.postJSON(getStarTransactions, resp, or)
 
 	if err != nil {
 		return nil, err
@@ -541,6 +930,1104 @@ func (api *TelegramBotAPI) GetProfilePhotos(op *model.OutgoingUserProfilePhotosR
 	return resp, nil
 }
 
+// RefundStarPayment refunds a successful payment in Telegram Stars.
+// The charge ID is the TelegramPaymentChargeID of the SuccessfulPayment.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) RefundStarPayment(userID int64, telegramPaymentChargeID string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		UserID                  int64  `json:"user_id"`
+		TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	}{
+		UserID:                  userID,
+		TelegramPaymentChargeID: telegramPaymentChargeID,
+	}
+	_, err := api.c.postJSON(refundStarPayment, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendGame sends a game.
+// Use NewOutgoingGame to construct the message to send.
+// On success, the sent message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SendGame(og *model.OutgoingGame) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(sendGame, resp, og)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(&resp.BaseResponse, &og.Recipient) {
+		return api.SendGame(og)
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetGameScore sets the score of a user in a game.
+// Use NewOutgoingGameScore to construct the score.
+// On success, the game message is returned as a MessageResponse.
+func (api *TelegramBotAPI) SetGameScore(os *model.OutgoingGameScore) (*model.MessageResponse, error) {
+	resp := &model.MessageResponse{}
+	_, err := api.c.postJSON(setGameScore, resp, os)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetGameHighScores gets the high scores for a user and their neighbors in the game contained in the message with ID
+// messageID.
+// On success, the high scores are returned as a GameHighScoresResponse.
+func (api *TelegramBotAPI) GetGameHighScores(recipient model.Recipient, messageID int, userID int64) (*model.GameHighScoresResponse, error) {
+	resp := &model.GameHighScoresResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+		MessageID int             `json:"message_id"`
+		UserID    int64           `json:"user_id"`
+	}{
+		Recipient: recipient,
+		MessageID: messageID,
+		UserID:    userID,
+	}
+	_, err := api.c.postJSON(getGameHighScores, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AnswerCallbackQuery answers a callback query sent from an inline keyboard button.
+// Use NewOutgoingCallbackQueryAnswer to create the answer, set its URL to open the game for game buttons.
+// Every callback query must be answered, otherwise the client shows a progress indicator on the button.
+func (api *TelegramBotAPI) AnswerCallbackQuery(oa *model.OutgoingCallbackQueryAnswer) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.postJSON(answerCallbackQuery, resp, oa)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetStickerSet gets the sticker set with the given name.
+// On success, the sticker set is returned as a StickerSetResponse.
+func (api *TelegramBotAPI) GetStickerSet(name string) (*model.StickerSetResponse, error) {
+	resp := &model.StickerSetResponse{}
+	_, err := api.c.getQuerystring(getStickerSet, resp, map[string]string{"name": name})
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetCustomEmojiStickers gets the stickers of the custom emoji with the given IDs.
+// On success, the stickers are returned as a StickerResponse.
+func (api *TelegramBotAPI) GetCustomEmojiStickers(customEmojiIDs ...string) (*model.StickerResponse, error) {
+	resp := &model.StickerResponse{}
+	toSend := struct {
+		CustomEmojiIDs []string `json:"custom_emoji_ids"`
+	}{
+		CustomEmojiIDs: customEmojiIDs,
+	}
+	_, err := api.c.postJSON(getCustomEmojiStickers, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UploadStickerFile uploads a sticker file for later use in sticker sets, owned by the user with ID userID.
+// On success, the uploaded file is returned as a FileResponse.
+func (api *TelegramBotAPI) UploadStickerFile(userID int64, format model.StickerFormat, filePath string) (*model.FileResponse, error) {
+	resp := &model.FileResponse{}
+	fields := querystringEncodable{
+		"user_id":        fmt.Sprint(userID),
+		"sticker_format": string(format),
+	}
+	_, err := api.c.uploadFile(uploadStickerFile, resp, file{fieldName: "sticker", path: filePath}, fields)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user.
+// Use NewOutgoingStickerSet to construct the sticker set. Stickers already on the Telegram servers and stickers to
+// upload can be mixed freely, see NewInputSticker and NewInputStickerUpload.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) CreateNewStickerSet(os *model.OutgoingStickerSet) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.uploadFiles(createNewStickerSet, resp, filesFrom(os.GetFiles()), os)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AddStickerToSet adds a sticker to a sticker set created by the bot.
+// Use NewOutgoingStickerAddition to construct the addition.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) AddStickerToSet(oa *model.OutgoingStickerAddition) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.uploadFiles(addStickerToSet, resp, filesFrom(oa.GetFiles()), oa)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetStickerPositionInSet moves a sticker in a set created by the bot to a specific, zero-based position.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetStickerPositionInSet(fileID string, position int) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Sticker  string `json:"sticker"`
+		Position int    `json:"position"`
+	}{
+		Sticker:  fileID,
+		Position: position,
+	}
+	_, err := api.c.postJSON(setStickerPositionInSet, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteStickerFromSet deletes a sticker from a set created by the bot.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeleteStickerFromSet(fileID string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Sticker string `json:"sticker"`
+	}{
+		Sticker: fileID,
+	}
+	_, err := api.c.postJSON(deleteStickerFromSet, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ResendStickerSetThumbnail sets the thumbnail of a sticker set to a file that is already on the Telegram servers.
+// The format must match the format of the stickers in the set.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) ResendStickerSetThumbnail(name string, userID int64, format model.StickerFormat, fileID string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Name      string              `json:"name"`
+		UserID    int64               `json:"user_id"`
+		Format    model.StickerFormat `json:"format"`
+		Thumbnail string              `json:"thumbnail,omitempty"`
+	}{
+		Name:      name,
+		UserID:    userID,
+		Format:    format,
+		Thumbnail: fileID,
+	}
+	_, err := api.c.postJSON(setStickerSetThumbnail, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetStickerSetThumbnail sets the thumbnail of a sticker set to a file that is not yet on the Telegram servers.
+// The format must match the format of the stickers in the set.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetStickerSetThumbnail(name string, userID int64, format model.StickerFormat, filePath string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	fields := querystringEncodable{
+		"name":    name,
+		"user_id": fmt.Sprint(userID),
+		"format":  string(format),
+	}
+	_, err := api.c.uploadFile(setStickerSetThumbnail, resp, file{fieldName: "thumbnail", path: filePath}, fields)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteStickerSetThumbnail removes the thumbnail of a sticker set, the first sticker is used instead.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeleteStickerSetThumbnail(name string, userID int64, format model.StickerFormat) (*model.BaseResponse, error) {
+	return api.ResendStickerSetThumbnail(name, userID, format, "")
+}
+
+// SetMyCommands sets the list of the bots commands for a scope and language.
+// Use NewOutgoingCommands to construct the list.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetMyCommands(oc *model.OutgoingCommands) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.postJSON(setMyCommands, resp, oc)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetMyCommands gets the list of the bots commands for a scope and language.
+// The scope may be nil and the language code empty to use the defaults, see OutgoingCommands.
+// On success, the commands are returned as a BotCommandsResponse.
+func (api *TelegramBotAPI) GetMyCommands(scope *model.BotCommandScope, languageCode string) (*model.BotCommandsResponse, error) {
+	resp := &model.BotCommandsResponse{}
+	toSend := struct {
+		Scope        *model.BotCommandScope `json:"scope,omitempty"`
+		LanguageCode string                 `json:"language_code,omitempty"`
+	}{
+		Scope:        scope,
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(getMyCommands, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteMyCommands deletes the list of the bots commands for a scope and language.
+// Users will see the commands of the next broader scope and language.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeleteMyCommands(scope *model.BotCommandScope, languageCode string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Scope        *model.BotCommandScope `json:"scope,omitempty"`
+		LanguageCode string                 `json:"language_code,omitempty"`
+	}{
+		Scope:        scope,
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(deleteMyCommands, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetMyName sets the name of the bot for users with the language, 0-64 characters.
+// An empty languageCode sets the name for all users without a dedicated one, an empty name removes it.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetMyName(name, languageCode string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Name         string `json:"name,omitempty"`
+		LanguageCode string `json:"language_code,omitempty"`
+	}{
+		Name:         name,
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(setMyName, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetMyName gets the name of the bot for users with the language.
+// On success, the name is returned as a BotNameResponse.
+func (api *TelegramBotAPI) GetMyName(languageCode string) (*model.BotNameResponse, error) {
+	resp := &model.BotNameResponse{}
+	toSend := struct {
+		LanguageCode string `json:"language_code,omitempty"`
+	}{
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(getMyName, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetMyDescription sets the description of the bot for users with the language, 0-512 characters.
+// The description is shown in empty chats with the bot.
+// An empty languageCode sets the description for all users without a dedicated one, an empty description removes it.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetMyDescription(description, languageCode string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Description  string `json:"description,omitempty"`
+		LanguageCode string `json:"language_code,omitempty"`
+	}{
+		Description:  description,
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(setMyDescription, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetMyDescription gets the description of the bot for users with the language.
+// On success, the description is returned as a BotDescriptionResponse.
+func (api *TelegramBotAPI) GetMyDescription(languageCode string) (*model.BotDescriptionResponse, error) {
+	resp := &model.BotDescriptionResponse{}
+	toSend := struct {
+		LanguageCode string `json:"language_code,omitempty"`
+	}{
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(getMyDescription, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetMyShortDescription sets the short description of the bot for users with the language, 0-120 characters.
+// The short description is shown on the profile page of the bot.
+// An empty languageCode sets the short description for all users without a dedicated one, an empty shortDescription removes it.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetMyShortDescription(shortDescription, languageCode string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		ShortDescription string `json:"short_description,omitempty"`
+		LanguageCode     string `json:"language_code,omitempty"`
+	}{
+		ShortDescription: shortDescription,
+		LanguageCode:     languageCode,
+	}
+	_, err := api.c.postJSON(setMyShortDescription, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetMyShortDescription gets the short description of the bot for users with the language.
+// On success, the short description is returned as a BotShortDescriptionResponse.
+func (api *TelegramBotAPI) GetMyShortDescription(languageCode string) (*model.BotShortDescriptionResponse, error) {
+	resp := &model.BotShortDescriptionResponse{}
+	toSend := struct {
+		LanguageCode string `json:"language_code,omitempty"`
+	}{
+		LanguageCode: languageCode,
+	}
+	_, err := api.c.postJSON(getMyShortDescription, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetChatMenuButton sets the menu button of the bot in the private chat with ID chatID.
+// If chatID is 0, the default menu button is set. If button is nil, the menu button is reset to the default.
+// Use NewCommandsMenuButton, NewWebAppMenuButton or NewDefaultMenuButton to construct the button.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetChatMenuButton(chatID int64, button *model.MenuButton) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		ChatID     int64             `json:"chat_id,omitempty"`
+		MenuButton *model.MenuButton `json:"menu_button,omitempty"`
+	}{
+		ChatID:     chatID,
+		MenuButton: button,
+	}
+	_, err := api.c.postJSON(setChatMenuButton, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetChatMenuButton gets the menu button of the bot in the private chat with ID chatID.
+// If chatID is 0, the default menu button is returned.
+// On success, the button is returned as a MenuButtonResponse.
+func (api *TelegramBotAPI) GetChatMenuButton(chatID int64) (*model.MenuButtonResponse, error) {
+	resp := &model.MenuButtonResponse{}
+	toSend := struct {
+		ChatID int64 `json:"chat_id,omitempty"`
+	}{
+		ChatID: chatID,
+	}
+	_, err := api.c.postJSON(getChatMenuButton, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetMyDefaultAdministratorRights sets the rights requested by default when the bot is added as an administrator to
+// groups, or channels if forChannels is set. If rights is nil, the default rights are cleared.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetMyDefaultAdministratorRights(rights *model.ChatAdministratorRights, forChannels bool) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Rights      *model.ChatAdministratorRights `json:"rights,omitempty"`
+		ForChannels bool                           `json:"for_channels,omitempty"`
+	}{
+		Rights:      rights,
+		ForChannels: forChannels,
+	}
+	_, err := api.c.postJSON(setMyDefaultAdministratorRights, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetMyDefaultAdministratorRights gets the rights requested by default when the bot is added as an administrator to
+// groups, or channels if forChannels is set.
+// On success, the rights are returned as an AdministratorRightsResponse.
+func (api *TelegramBotAPI) GetMyDefaultAdministratorRights(forChannels bool) (*model.AdministratorRightsResponse, error) {
+	resp := &model.AdministratorRightsResponse{}
+	toSend := struct {
+		ForChannels bool `json:"for_channels,omitempty"`
+	}{
+		ForChannels: forChannels,
+	}
+	_, err := api.c.postJSON(getMyDefaultAdministratorRights, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ExportChatInviteLink generates a new primary invite link for a chat, revoking the previous one.
+// On success, the new link is returned as an InviteLinkResponse.
+func (api *TelegramBotAPI) ExportChatInviteLink(recipient model.Recipient) (*model.InviteLinkResponse, error) {
+	resp := &model.InviteLinkResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+	}{
+		Recipient: recipient,
+	}
+	_, err := api.c.postJSON(exportChatInviteLink, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateChatInviteLink creates an additional invite link for a chat.
+// Use NewOutgoingChatInviteLink to construct the link.
+// On success, the link is returned as a ChatInviteLinkResponse.
+func (api *TelegramBotAPI) CreateChatInviteLink(ol *model.OutgoingChatInviteLink) (*model.ChatInviteLinkResponse, error) {
+	resp := &model.ChatInviteLinkResponse{}
+	_, err := api.c.postJSON(createChatInviteLink, resp, ol)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EditChatInviteLink edits a non-primary invite link created by the bot.
+// Use NewOutgoingChatInviteLinkEdit to construct the edit.
+// On success, the edited link is returned as a ChatInviteLinkResponse.
+func (api *TelegramBotAPI) EditChatInviteLink(ol *model.OutgoingChatInviteLink) (*model.ChatInviteLinkResponse, error) {
+	resp := &model.ChatInviteLinkResponse{}
+	_, err := api.c.postJSON(editChatInviteLink, resp, ol)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RevokeChatInviteLink revokes an invite link created by the bot.
+// If the primary link is revoked, a new one is generated.
+// On success, the revoked link is returned as a ChatInviteLinkResponse.
+func (api *TelegramBotAPI) RevokeChatInviteLink(recipient model.Recipient, inviteLink string) (*model.ChatInviteLinkResponse, error) {
+	resp := &model.ChatInviteLinkResponse{}
+	toSend := struct {
+		Recipient  model.Recipient `json:"chat_id"`
+		InviteLink string          `json:"invite_link"`
+	}{
+		Recipient:  recipient,
+		InviteLink: inviteLink,
+	}
+	_, err := api.c.postJSON(revokeChatInviteLink, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ApproveChatJoinRequest approves the join request of the user with ID userID.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) ApproveChatJoinRequest(recipient model.Recipient, userID int64) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+		UserID    int64           `json:"user_id"`
+	}{
+		Recipient: recipient,
+		UserID:    userID,
+	}
+	_, err := api.c.postJSON(approveChatJoinRequest, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeclineChatJoinRequest declines the join request of the user with ID userID.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeclineChatJoinRequest(recipient model.Recipient, userID int64) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+		UserID    int64           `json:"user_id"`
+	}{
+		Recipient: recipient,
+		UserID:    userID,
+	}
+	_, err := api.c.postJSON(declineChatJoinRequest, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PinChatMessage pins a message in a chat.
+// If disableNotification is set, chat members are not notified about the pinned message.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) PinChatMessage(recipient model.Recipient, messageID int, disableNotification bool) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient           model.Recipient `json:"chat_id"`
+		MessageID           int             `json:"message_id"`
+		DisableNotification bool            `json:"disable_notification,omitempty"`
+	}{
+		Recipient:           recipient,
+		MessageID:           messageID,
+		DisableNotification: disableNotification,
+	}
+	_, err := api.c.postJSON(pinChatMessage, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UnpinChatMessage unpins a message in a chat.
+// If messageID is 0, the most recently pinned message is unpinned.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) UnpinChatMessage(recipient model.Recipient, messageID int) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+		MessageID int             `json:"message_id,omitempty"`
+	}{
+		Recipient: recipient,
+		MessageID: messageID,
+	}
+	_, err := api.c.postJSON(unpinChatMessage, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UnpinAllChatMessages unpins all pinned messages in a chat.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) UnpinAllChatMessages(recipient model.Recipient) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+	}{
+		Recipient: recipient,
+	}
+	_, err := api.c.postJSON(unpinAllChatMessages, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetChatTitle changes the title of a chat, 1-128 characters.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetChatTitle(recipient model.Recipient, title string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+		Title     string          `json:"title"`
+	}{
+		Recipient: recipient,
+		Title:     title,
+	}
+	_, err := api.c.postJSON(setChatTitle, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetChatDescription changes the description of a chat, 0-255 characters.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetChatDescription(recipient model.Recipient, description string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient   model.Recipient `json:"chat_id"`
+		Description string          `json:"description"`
+	}{
+		Recipient:   recipient,
+		Description: description,
+	}
+	_, err := api.c.postJSON(setChatDescription, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetChatPhoto sets the photo of a chat to a file that is not yet on the Telegram servers.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetChatPhoto(recipient model.Recipient, filePath string) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.uploadFile(setChatPhoto, resp, file{fieldName: "photo", path: filePath}, model.NewOutgoingChatPhoto(recipient))
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteChatPhoto deletes the photo of a chat.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeleteChatPhoto(recipient model.Recipient) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient model.Recipient `json:"chat_id"`
+	}{
+		Recipient: recipient,
+	}
+	_, err := api.c.postJSON(deleteChatPhoto, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateForumTopic creates a topic in a forum supergroup.
+// Use NewOutgoingForumTopic to construct the topic.
+// On success, the created topic is returned as a ForumTopicResponse.
+func (api *TelegramBotAPI) CreateForumTopic(of *model.OutgoingForumTopic) (*model.ForumTopicResponse, error) {
+	resp := &model.ForumTopicResponse{}
+	_, err := api.c.postJSON(createForumTopic, resp, of)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EditForumTopic edits the name and icon of a topic in a forum supergroup.
+// Use NewOutgoingForumTopicEdit to construct the edit.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) EditForumTopic(oe *model.OutgoingForumTopicEdit) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.postJSON(editForumTopic, resp, oe)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CloseForumTopic closes an open topic in a forum supergroup.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) CloseForumTopic(recipient model.Recipient, messageThreadID int) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient       model.Recipient `json:"chat_id"`
+		MessageThreadID int             `json:"message_thread_id"`
+	}{
+		Recipient:       recipient,
+		MessageThreadID: messageThreadID,
+	}
+	_, err := api.c.postJSON(closeForumTopic, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReopenForumTopic reopens a closed topic in a forum supergroup.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) ReopenForumTopic(recipient model.Recipient, messageThreadID int) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient       model.Recipient `json:"chat_id"`
+		MessageThreadID int             `json:"message_thread_id"`
+	}{
+		Recipient:       recipient,
+		MessageThreadID: messageThreadID,
+	}
+	_, err := api.c.postJSON(reopenForumTopic, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteForumTopic deletes a topic in a forum supergroup, along with all its messages.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeleteForumTopic(recipient model.Recipient, messageThreadID int) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient       model.Recipient `json:"chat_id"`
+		MessageThreadID int             `json:"message_thread_id"`
+	}{
+		Recipient:       recipient,
+		MessageThreadID: messageThreadID,
+	}
+	_, err := api.c.postJSON(deleteForumTopic, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteMessages deletes between 1 and 100 messages in a chat at once.
+// Messages that cannot be found are skipped.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) DeleteMessages(recipient model.Recipient, messageIDs ...int) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		Recipient  model.Recipient `json:"chat_id"`
+		MessageIDs []int           `json:"message_ids"`
+	}{
+		Recipient:  recipient,
+		MessageIDs: messageIDs,
+	}
+	_, err := api.c.postJSON(deleteMessages, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetMessageReaction sets the reactions of the bot on a message, replacing its previous reactions.
+// Use NewOutgoingReaction to construct the reactions.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SetMessageReaction(or *model.OutgoingReaction) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	_, err := api.c.postJSON(setMessageReaction, resp, or)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AnswerWebAppQuery sends a message on behalf of the user that opened a Web App, as the result of the Web App
+// session with ID webAppQueryID. Use the webapp package to obtain the ID from the init data of the Web App.
+// On success, the sent message is returned as a SentWebAppMessageResponse.
+func (api *TelegramBotAPI) AnswerWebAppQuery(webAppQueryID string, result model.InlineQueryResult) (*model.SentWebAppMessageResponse, error) {
+	resp := &model.SentWebAppMessageResponse{}
+	toSend := struct {
+		WebAppQueryID string                  `json:"web_app_query_id"`
+		Result        model.InlineQueryResult `json:"result"`
+	}{
+		WebAppQueryID: webAppQueryID,
+		Result:        result,
+	}
+	_, err := api.c.postJSON(answerWebAppQuery, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SendChatAction sends a chat action to the specified chatID.
+// Use the ChatAction constants to specify the action.
+// On success, a BaseResponse is returned.
+func (api *TelegramBotAPI) SendChatAction(recipient model.Recipient, action model.ChatAction) (*model.BaseResponse, error) {
+	resp := &model.BaseResponse{}
+	toSend := struct {
+		model.OutgoingBase
+		Action string `json:"action"`
+	}{
+		OutgoingBase: model.OutgoingBase{
+			Recipient: recipient,
+		},
+		Action: string(action),
+	}
+	_, err := api.c.postJSON(sendChatAction, resp, toSend)
+
+	if err != nil {
+		return nil, err
+	}
+	if api.followMigration(resp, &recipient) {
+		return api.SendChatAction(recipient, action)
+	}
+	err = check(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetProfilePhotos gets a users profile pictures.
+// Use NewOutgoingUserProfilePhotosRequest to create the request.
+// On success, the photos are returned as a UserProfilePhotosResponse.
+func (api *TelegramBotAPI) GetProfilePhotos(op *model.OutgoingUserProfilePhotosRequest) (*model.UserProfilePhotosResponse, error) {
+	resp := &model.UserProfilePhotosResponse{}
+	_, err := api.c.postJSON(getUserProfilePhotos, resp, op)
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// followMigration checks if a failed request should be repeated against the supergroup the
+// recipient was migrated to. If so, the recipient is updated to address the supergroup.
+func (api *TelegramBotAPI) followMigration(br *model.BaseResponse, recipient *model.Recipient) bool {
+	if !api.FollowMigrations || br.Ok || br.Parameters == nil || br.Parameters.MigrateToChatID == nil {
+		return false
+	}
+	if recipient.ChatID == nil || *recipient.ChatID == *br.Parameters.MigrateToChatID {
+		return false
+	}
+
+	*recipient = model.NewChatRecipient(*br.Parameters.MigrateToChatID)
+	return true
+}
+
 func check(br *model.BaseResponse) error {
 	if br.Ok {
 		return nil
@@ -0,0 +1,652 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	fakeToken  = "123456:TEST"
+	fakeBotID  = 123456
+	fakeUserID = 8589934592
+	fakeChatID = 8589934592
+)
+
+// fakeHandler answers a Bot API method call with the result to return
+type fakeHandler func(params map[string]interface{}) interface{}
+
+// fakeError can be returned by a fakeHandler to make the method fail
+type fakeError struct {
+	Code        int
+	Description string
+	Parameters  map[string]interface{} // optional response parameters, for example migrate_to_chat_id
+}
+
+// fakeUpload is the parameter recorded for a file uploaded in a method call
+type fakeUpload struct {
+	Filename string
+	Content  string
+}
+
+// fakeCall records a Bot API method call received by a fakeBot
+type fakeCall struct {
+	Method string
+	Params map[string]interface{}
+}
+
+// A fakeBot is a fake Bot API server.
+// Methods are answered by registered handlers, unknown methods fail like they do with the real API. Updates queued
+// with queueUpdate are delivered via getUpdates.
+type fakeBot struct {
+	t        *testing.T
+	server   *httptest.Server
+	updates  chan json.RawMessage
+	mu       sync.Mutex
+	handlers map[string]fakeHandler // by lowercased method name
+	calls    []fakeCall
+	updateID int
+}
+
+func newFakeBot(t *testing.T) *fakeBot {
+	f := &fakeBot{
+		t:        t,
+		updates:  make(chan json.RawMessage, 100),
+		handlers: map[string]fakeHandler{},
+	}
+	f.handle("getMe", func(map[string]interface{}) interface{} {
+		return map[string]interface{}{"id": fakeBotID, "is_bot": true, "first_name": "Fake", "username": "fake_bot"}
+	})
+
+	f.server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// connect creates a client for the fake server, which is closed when the test ends
+func (f *fakeBot) connect() *TelegramBotAPI {
+	api, err := NewWithServer(fakeToken, f.server.URL)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.t.Cleanup(api.Close)
+	return api
+}
+
+// handle registers the handler for the method
+func (f *fakeBot) handle(method string, h fakeHandler) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[strings.ToLower(method)] = h
+}
+
+// queueUpdate queues an update of the given kind, for example "message", with the payload.
+// It may be called from handlers, so failures are reported with Error instead of stopping the test.
+func (f *fakeBot) queueUpdate(kind string, payload interface{}) {
+	f.mu.Lock()
+	f.updateID++
+	b, err := json.Marshal(map[string]interface{}{"update_id": f.updateID, kind: payload})
+	f.mu.Unlock()
+	if err != nil {
+		f.t.Error(err)
+		return
+	}
+	f.updates <- b
+}
+
+// callsTo returns the calls received for the method
+func (f *fakeBot) callsTo(method string) []fakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var toReturn []fakeCall
+	for _, c := range f.calls {
+		if strings.EqualFold(c.Method, method) {
+			toReturn = append(toReturn, c)
+		}
+	}
+	return toReturn
+}
+
+func (f *fakeBot) serve(w http.ResponseWriter, r *http.Request) {
+	prefix := "/bot" + fakeToken + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeFakeResponse(w, http.StatusUnauthorized, map[string]interface{}{"ok": false, "error_code": 401, "description": "Unauthorized"})
+		return
+	}
+	method := strings.TrimPrefix(r.URL.Path, prefix)
+
+	if strings.EqualFold(method, "getUpdates") {
+		writeFakeResponse(w, http.StatusOK, map[string]interface{}{"ok": true, "result": f.pollUpdates()})
+		return
+	}
+
+	params, err := fakeParams(r)
+	if err != nil {
+		writeFakeResponse(w, http.StatusBadRequest, map[string]interface{}{"ok": false, "error_code": 400, "description": err.Error()})
+		return
+	}
+
+	f.mu.Lock()
+	f.calls = append(f.calls, fakeCall{Method: method, Params: params})
+	h, ok := f.handlers[strings.ToLower(method)]
+	f.mu.Unlock()
+
+	if !ok {
+		writeFakeResponse(w, http.StatusNotFound, map[string]interface{}{"ok": false, "error_code": 404, "description": "Not Found: method not found"})
+		return
+	}
+	result := h(params)
+	if e, ok := result.(fakeError); ok {
+		body := map[string]interface{}{"ok": false, "error_code": e.Code, "description": e.Description}
+		if e.Parameters != nil {
+			body["parameters"] = e.Parameters
+		}
+		writeFakeResponse(w, e.Code, body)
+		return
+	}
+	writeFakeResponse(w, http.StatusOK, map[string]interface{}{"ok": true, "result": result})
+}
+
+// pollUpdates waits briefly for queued updates, like a short long poll
+func (f *fakeBot) pollUpdates() []json.RawMessage {
+	toReturn := []json.RawMessage{}
+	select {
+	case u := <-f.updates:
+		toReturn = append(toReturn, u)
+	case <-time.After(20 * time.Millisecond):
+		return toReturn
+	}
+	for {
+		select {
+		case u := <-f.updates:
+			toReturn = append(toReturn, u)
+		default:
+			return toReturn
+		}
+	}
+}
+
+// fakeParams decodes the parameters of a method call sent as JSON or form data.
+// Uploaded files are recorded as fakeUpload.
+func fakeParams(r *http.Request) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		d := json.NewDecoder(r.Body)
+		d.UseNumber()
+		err := d.Decode(&params)
+		return params, err
+	}
+
+	if err := r.ParseMultipartForm(1 << 20); err != nil && err != http.ErrNotMultipart {
+		return nil, err
+	}
+	for key, values := range r.Form {
+		params[key] = values[0]
+	}
+	if r.MultipartForm == nil {
+		return params, nil
+	}
+	for key, headers := range r.MultipartForm.File {
+		f, err := headers[0].Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		params[key] = fakeUpload{Filename: headers[0].Filename, Content: string(content)}
+	}
+	return params, nil
+}
+
+func writeFakeResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// fakeInt returns the integer parameter, for parameters decoded from JSON
+func fakeInt(params map[string]interface{}, key string) int64 {
+	n, _ := params[key].(json.Number).Int64()
+	return n
+}
+
+// fakeTotal sums the amounts of a list of labeled prices decoded from JSON
+func fakeTotal(prices interface{}) int64 {
+	var toReturn int64
+	for _, price := range prices.([]interface{}) {
+		n, _ := price.(map[string]interface{})["amount"].(json.Number).Int64()
+		toReturn += n
+	}
+	return toReturn
+}
+
+func TestNewWithServer(t *testing.T) {
+	api := newFakeBot(t).connect()
+
+	if api.ID != fakeBotID || api.Username != "fake_bot" {
+		t.Errorf("got ID %d, username %s", api.ID, api.Username)
+	}
+}
+
+func TestSetAllowedUpdates(t *testing.T) {
+	api := &TelegramBotAPI{}
+
+	// the API default excludes reactions and chat member updates
+	if allowed, ok := api.updateQuerystring()["allowed_updates"]; ok {
+		t.Errorf("got allowed_updates %s by default", allowed)
+	}
+
+	api.SetAllowedUpdates(model.MessageUpdate, model.MessageReactionUpdate)
+	if got, want := api.updateQuerystring()["allowed_updates"], `["message","message_reaction"]`; got != want {
+		t.Errorf("got allowed_updates %s, want %s", got, want)
+	}
+
+	api.SetAllowedUpdates(model.AllUpdateKinds()...)
+	allowed := api.updateQuerystring()["allowed_updates"]
+	for _, kind := range []string{`"message"`, `"chat_member"`, `"message_reaction"`, `"message_reaction_count"`} {
+		if !strings.Contains(allowed, kind) {
+			t.Errorf("allowed_updates %s does not contain %s", allowed, kind)
+		}
+	}
+
+	api.SetAllowedUpdates()
+	if allowed, ok := api.updateQuerystring()["allowed_updates"]; ok {
+		t.Errorf("got allowed_updates %s after restoring the default", allowed)
+	}
+}
+
+func TestUnknownMethod(t *testing.T) {
+	api := newFakeBot(t).connect()
+
+	if _, err := api.SendMessage(fakeChatID, "hello"); err == nil {
+		t.Error("no error for a method failing on the server")
+	}
+}
+
+func TestFollowMigrations(t *testing.T) {
+	const groupID, supergroupID = -123, -1001234567890123
+
+	for _, follow := range []bool{false, true} {
+		f := newFakeBot(t)
+		f.handle("sendMessage", func(params map[string]interface{}) interface{} {
+			if chatID := fakeInt(params, "chat_id"); chatID != supergroupID {
+				return fakeError{Code: 400, Description: "Bad Request: group chat was upgraded to a supergroup chat",
+					Parameters: map[string]interface{}{"migrate_to_chat_id": supergroupID}}
+			}
+			return map[string]interface{}{"message_id": 1, "date": 1700000000, "text": params["text"],
+				"chat": map[string]interface{}{"id": supergroupID, "type": "supergroup"}}
+		})
+		api := f.connect()
+		api.FollowMigrations = follow
+
+		om := model.NewOutgoingMessage(model.NewChatRecipient(groupID), "hello")
+		resp, err := api.SendMessageExtended(om)
+
+		calls := f.callsTo("sendMessage")
+		if !follow {
+			if err == nil || len(calls) != 1 || *om.Recipient.ChatID != groupID {
+				t.Errorf("without FollowMigrations: got error %v after %d calls to %d", err, len(calls), *om.Recipient.ChatID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Message.Chat.ID != supergroupID {
+			t.Errorf("message sent to %d", resp.Message.Chat.ID)
+		}
+		if len(calls) != 2 || fakeInt(calls[0].Params, "chat_id") != groupID || fakeInt(calls[1].Params, "chat_id") != supergroupID {
+			t.Errorf("unexpected calls %v", calls)
+		}
+		// the recipient of the outgoing message is updated, as documented
+		if *om.Recipient.ChatID != supergroupID {
+			t.Errorf("recipient was not updated to the supergroup, got %d", *om.Recipient.ChatID)
+		}
+	}
+}
+
+// TestCheckout drives one checkout with shipping through the fake server, as the Telegram client and payment
+// provider would: the user opens the invoice and enters an address, chooses a shipping option and pays.
+func TestCheckout(t *testing.T) {
+	f := newFakeBot(t)
+	user := map[string]interface{}{"id": fakeUserID, "is_bot": false, "first_name": "Buyer"}
+
+	var invoiceTotal, total int64
+	var payload, currency string
+
+	f.handle("sendInvoice", func(params map[string]interface{}) interface{} {
+		payload, currency = params["payload"].(string), params["currency"].(string)
+		invoiceTotal = fakeTotal(params["prices"])
+
+		f.queueUpdate("shipping_query", map[string]interface{}{
+			"id":              "shipping-1",
+			"from":            user,
+			"invoice_payload": payload,
+			"shipping_address": map[string]interface{}{
+				"country_code": "DE", "state": "", "city": "Berlin",
+				"street_line1": "Street 1", "street_line2": "", "post_code": "10115",
+			},
+		})
+		return map[string]interface{}{
+			"message_id": 10,
+			"date":       1700000000,
+			"chat":       map[string]interface{}{"id": fakeInt(params, "chat_id"), "type": "private"},
+			"invoice": map[string]interface{}{
+				"title": params["title"], "description": params["description"],
+				"start_parameter": "", "currency": currency, "total_amount": invoiceTotal,
+			},
+		}
+	})
+
+	f.handle("answerShippingQuery", func(params map[string]interface{}) interface{} {
+		if params["ok"] != true {
+			return true
+		}
+		option := params["shipping_options"].([]interface{})[0].(map[string]interface{})
+		total = invoiceTotal + fakeTotal(option["prices"])
+
+		f.queueUpdate("pre_checkout_query", map[string]interface{}{
+			"id":                 "checkout-1",
+			"from":               user,
+			"currency":           currency,
+			"total_amount":       total,
+			"invoice_payload":    payload,
+			"shipping_option_id": option["id"],
+		})
+		return true
+	})
+
+	f.handle("answerPreCheckoutQuery", func(params map[string]interface{}) interface{} {
+		if params["ok"] != true {
+			return true
+		}
+		f.queueUpdate("message", map[string]interface{}{
+			"message_id": 11,
+			"date":       1700000100,
+			"chat":       map[string]interface{}{"id": fakeChatID, "type": "private"},
+			"from":       user,
+			"successful_payment": map[string]interface{}{
+				"currency":                   currency,
+				"total_amount":               total,
+				"invoice_payload":            payload,
+				"shipping_option_id":         "standard",
+				"telegram_payment_charge_id": "tg-charge-1",
+				"provider_payment_charge_id": "provider-charge-1",
+			},
+		})
+		return true
+	})
+
+	api := f.connect()
+
+	details := model.NewInvoiceDetails("Book", "A good book", "order-1", "provider-token", "EUR",
+		model.LabeledPrice{Label: "Book", Amount: 1500}, model.LabeledPrice{Label: "Tax", Amount: 300}).
+		SetNeedShippingAddress(true).SetFlexible(true)
+	sent, err := api.SendInvoice(model.NewOutgoingInvoice(model.NewChatRecipient(fakeChatID), details))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.Message.Type() != model.InvoiceType || sent.Message.Invoice.TotalAmount != 1800 {
+		t.Fatalf("unexpected sent invoice %+v", sent.Message.Invoice)
+	}
+
+	var payment *model.SuccessfulPayment
+	timeout := time.After(5 * time.Second)
+	for payment == nil {
+		select {
+		case update := <-api.Updates:
+			switch update.Kind() {
+			case model.ShippingQueryUpdate:
+				if update.ShippingQuery.ShippingAddress.CountryCode != "DE" {
+					t.Errorf("unexpected shipping address %+v", update.ShippingQuery.ShippingAddress)
+				}
+				option := model.ShippingOption{ID: "standard", Title: "Standard", Prices: []model.LabeledPrice{{Label: "Shipping", Amount: 499}}}
+				if _, err = api.AnswerShippingQuery(model.NewOutgoingShippingQueryAnswer(update.ShippingQuery.ID, option)); err != nil {
+					t.Fatal(err)
+				}
+			case model.PreCheckoutQueryUpdate:
+				query := update.PreCheckoutQuery
+				if query.InvoicePayload != "order-1" || query.TotalAmount != 2299 {
+					t.Errorf("unexpected pre-checkout query %+v", query)
+				}
+				if _, err = api.AnswerPreCheckoutQuery(model.NewOutgoingPreCheckoutQueryAnswer(query.ID)); err != nil {
+					t.Fatal(err)
+				}
+			case model.MessageUpdate:
+				if update.Message.Type() != model.SuccessfulPaymentType {
+					t.Fatalf("unexpected message of type %s", update.Message.Type())
+				}
+				payment = update.Message.SuccessfulPayment
+			default:
+				t.Fatalf("unexpected update of kind %s", update.Kind())
+			}
+		case err = <-api.Errors:
+			t.Fatal(err)
+		case <-timeout:
+			t.Fatal("checkout did not complete")
+		}
+	}
+
+	if payment.InvoicePayload != "order-1" || payment.TotalAmount != 2299 || payment.Currency != "EUR" {
+		t.Errorf("unexpected payment %+v", payment)
+	}
+	if payment.ShippingOptionID == nil || *payment.ShippingOptionID != "standard" {
+		t.Errorf("unexpected shipping option %v", payment.ShippingOptionID)
+	}
+
+	invoice := f.callsTo("sendInvoice")[0].Params
+	if invoice["provider_token"] != "provider-token" || invoice["is_flexible"] != true || invoice["need_shipping_address"] != true {
+		t.Errorf("unexpected invoice parameters %v", invoice)
+	}
+	for _, method := range []string{"sendInvoice", "answerShippingQuery", "answerPreCheckoutQuery"} {
+		if n := len(f.callsTo(method)); n != 1 {
+			t.Errorf("%s was called %d times", method, n)
+		}
+	}
+}
+
+func TestGame(t *testing.T) {
+	f := newFakeBot(t)
+	user := map[string]interface{}{"id": fakeUserID, "is_bot": false, "first_name": "Player"}
+	gameMessage := func(params map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"message_id": 20,
+			"date":       1700000000,
+			"chat":       map[string]interface{}{"id": fakeInt(params, "chat_id"), "type": "private"},
+			"game":       map[string]interface{}{"title": "Race", "description": "A race", "photo": []interface{}{}},
+		}
+	}
+
+	f.handle("sendGame", func(params map[string]interface{}) interface{} {
+		f.queueUpdate("callback_query", map[string]interface{}{
+			"id":              "query-1",
+			"from":            user,
+			"message":         gameMessage(params),
+			"chat_instance":   "instance-1",
+			"game_short_name": params["game_short_name"],
+		})
+		return gameMessage(params)
+	})
+	f.handle("answerCallbackQuery", func(map[string]interface{}) interface{} { return true })
+	f.handle("setGameScore", func(params map[string]interface{}) interface{} { return gameMessage(params) })
+	f.handle("getGameHighScores", func(map[string]interface{}) interface{} {
+		return []interface{}{map[string]interface{}{"position": 1, "user": user, "score": 42}}
+	})
+
+	api := f.connect()
+
+	recipient := model.NewChatRecipient(fakeChatID)
+	sent, err := api.SendGame(model.NewOutgoingGame(recipient, "race"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.Message.Type() != model.GameType {
+		t.Fatalf("sent message has type %s", sent.Message.Type())
+	}
+
+	select {
+	case update := <-api.Updates:
+		query := update.CallbackQuery
+		if update.Kind() != model.CallbackQueryUpdate || query.GameShortName == nil || *query.GameShortName != "race" {
+			t.Fatalf("unexpected update %+v", update)
+		}
+		if update.Sender().ID != fakeUserID || update.Chat().ID != fakeChatID {
+			t.Errorf("got sender %+v and chat %+v", update.Sender(), update.Chat())
+		}
+		if _, err = api.AnswerCallbackQuery(model.NewOutgoingCallbackQueryAnswer(query.ID).SetURL("https://example.com/race")); err != nil {
+			t.Fatal(err)
+		}
+	case err = <-api.Errors:
+		t.Fatal(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("callback query was not received")
+	}
+
+	if _, err = api.SetGameScore(model.NewOutgoingGameScore(recipient, 20, fakeUserID, 42)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.SetGameScore(model.NewOutgoingGameScore(recipient, 20, fakeUserID, 7).SetForce(true).SetDisableEditMessage(true)); err != nil {
+		t.Fatal(err)
+	}
+	scores, err := api.GetGameHighScores(recipient, 20, fakeUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scores.HighScores) != 1 || scores.HighScores[0].Score != 42 || scores.HighScores[0].User.ID != fakeUserID {
+		t.Errorf("unexpected high scores %+v", scores.HighScores)
+	}
+
+	if params := f.callsTo("sendGame")[0].Params; params["game_short_name"] != "race" || fakeInt(params, "chat_id") != fakeChatID {
+		t.Errorf("unexpected sendGame parameters %v", params)
+	}
+	answer := f.callsTo("answerCallbackQuery")[0].Params
+	if answer["callback_query_id"] != "query-1" || answer["url"] != "https://example.com/race" {
+		t.Errorf("unexpected answerCallbackQuery parameters %v", answer)
+	}
+	for _, key := range []string{"text", "show_alert", "cache_time"} {
+		if _, ok := answer[key]; ok {
+			t.Errorf("unset %s was sent", key)
+		}
+	}
+
+	scoreCalls := f.callsTo("setGameScore")
+	if len(scoreCalls) != 2 {
+		t.Fatalf("setGameScore was called %d times", len(scoreCalls))
+	}
+	plain, forced := scoreCalls[0].Params, scoreCalls[1].Params
+	if fakeInt(plain, "score") != 42 || fakeInt(plain, "user_id") != fakeUserID || fakeInt(plain, "message_id") != 20 {
+		t.Errorf("unexpected setGameScore parameters %v", plain)
+	}
+	for _, key := range []string{"force", "disable_edit_message"} {
+		if _, ok := plain[key]; ok {
+			t.Errorf("unset %s was sent", key)
+		}
+		if forced[key] != true {
+			t.Errorf("got %s=%v, want true", key, forced[key])
+		}
+	}
+
+	highScores := f.callsTo("getGameHighScores")[0].Params
+	if fakeInt(highScores, "chat_id") != fakeChatID || fakeInt(highScores, "message_id") != 20 || fakeInt(highScores, "user_id") != fakeUserID {
+		t.Errorf("unexpected getGameHighScores parameters %v", highScores)
+	}
+}
+
+func TestStickerSets(t *testing.T) {
+	f := newFakeBot(t)
+	for _, method := range []string{"createNewStickerSet", "setStickerSetThumbnail"} {
+		f.handle(method, func(map[string]interface{}) interface{} { return true })
+	}
+	api := f.connect()
+
+	dir := t.TempDir()
+	stickerPath, thumbnailPath := filepath.Join(dir, "sticker.webp"), filepath.Join(dir, "thumbnail.webp")
+	if err := os.WriteFile(stickerPath, []byte("sticker"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(thumbnailPath, []byte("thumbnail"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	set := model.NewOutgoingStickerSet(fakeUserID, "set_by_fake_bot", "Set",
+		model.NewInputSticker("sticker-1", model.StickerFormatStatic, "👍"),
+		model.NewInputStickerUpload(stickerPath, model.StickerFormatStatic, "👎").SetKeywords("down"))
+	if _, err := api.CreateNewStickerSet(set); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.SetStickerSetThumbnail("set_by_fake_bot", fakeUserID, model.StickerFormatStatic, thumbnailPath); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.DeleteStickerSetThumbnail("set_by_fake_bot", fakeUserID, model.StickerFormatStatic); err != nil {
+		t.Fatal(err)
+	}
+
+	created := f.callsTo("createNewStickerSet")[0].Params
+	if created["user_id"] != "8589934592" || created["name"] != "set_by_fake_bot" || created["title"] != "Set" {
+		t.Errorf("unexpected createNewStickerSet parameters %v", created)
+	}
+	var stickers []map[string]interface{}
+	if err := json.Unmarshal([]byte(created["stickers"].(string)), &stickers); err != nil {
+		t.Fatal(err)
+	}
+	if len(stickers) != 2 || stickers[0]["sticker"] != "sticker-1" || stickers[0]["format"] != "static" {
+		t.Fatalf("unexpected stickers %v", stickers)
+	}
+	field := strings.TrimPrefix(stickers[1]["sticker"].(string), "attach://")
+	if upload, ok := created[field].(fakeUpload); !ok || upload.Content != "sticker" {
+		t.Errorf("sticker references %s, got %v", stickers[1]["sticker"], created[field])
+	}
+
+	calls := f.callsTo("setStickerSetThumbnail")
+	if len(calls) != 2 {
+		t.Fatalf("setStickerSetThumbnail was called %d times", len(calls))
+	}
+	uploaded, deleted := calls[0].Params, calls[1].Params
+	if uploaded["name"] != "set_by_fake_bot" || uploaded["user_id"] != "8589934592" || uploaded["format"] != "static" {
+		t.Errorf("unexpected setStickerSetThumbnail parameters %v", uploaded)
+	}
+	if upload, ok := uploaded["thumbnail"].(fakeUpload); !ok || upload.Content != "thumbnail" {
+		t.Errorf("got thumbnail %v", uploaded["thumbnail"])
+	}
+	if deleted["name"] != "set_by_fake_bot" || fakeInt(deleted, "user_id") != fakeUserID || deleted["format"] != "static" {
+		t.Errorf("unexpected parameters for deletion %v", deleted)
+	}
+	if _, ok := deleted["thumbnail"]; ok {
+		t.Errorf("deletion sent thumbnail %v", deleted["thumbnail"])
+	}
+}
+
+func TestSetChatPhoto(t *testing.T) {
+	f := newFakeBot(t)
+	f.handle("setChatPhoto", func(map[string]interface{}) interface{} { return true })
+	api := f.connect()
+
+	path := filepath.Join(t.TempDir(), "photo.jpg")
+	if err := os.WriteFile(path, []byte("photo"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.SetChatPhoto(model.NewChatRecipient(-100), path); err != nil {
+		t.Fatal(err)
+	}
+
+	params := f.callsTo("setChatPhoto")[0].Params
+	if params["chat_id"] != "-100" {
+		t.Errorf("got chat_id %v", params["chat_id"])
+	}
+	if upload, ok := params["photo"].(fakeUpload); !ok || upload.Filename != "photo.jpg" || upload.Content != "photo" {
+		t.Errorf("got photo %v", params["photo"])
+	}
+}
@@ -0,0 +1,72 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"context"
+	"encoding/json"
+)
+
+// Call calls an arbitrary Bot API method, for example one that was released after this library.
+// The params are sent as JSON, or as multipart form data if they implement GetQueryString and GetFiles like the
+// outgoing types of the model package that contain files to upload. Nil params send no parameters.
+// If the API returns an error, it is returned like for any other method. Otherwise, the result is unmarshaled into
+// result, which may be nil if the result is not needed.
+// If ctx is done before the API responds, Call returns the error of ctx and result is left untouched. The request
+// itself is not aborted.
+func (api *TelegramBotAPI) Call(ctx context.Context, methodName string, params interface{}, result interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// the request outlives Call if ctx is done first, so it must not touch result
+	type response struct {
+		result json.RawMessage
+		err    error
+	}
+	done := make(chan response, 1)
+	go func() {
+		raw, err := api.call(methodName, params)
+		done <- response{raw, err}
+	}()
+
+	select {
+	case resp := <-done:
+		if resp.err != nil || result == nil {
+			return resp.err
+		}
+		return json.Unmarshal(resp.result, result)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (api *TelegramBotAPI) call(methodName string, params interface{}) (json.RawMessage, error) {
+	resp := &struct {
+		model.BaseResponse
+		Result json.RawMessage `json:"result"`
+	}{}
+
+	var err error
+	if u, ok := params.(uploadable); ok {
+		_, err = api.c.uploadFilesRaw(methodName, resp, filesFrom(u.GetFiles()), u)
+	} else {
+		_, err = api.c.postJSONRaw(methodName, resp, params)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	err = check(&resp.BaseResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// Do calls an arbitrary Bot API method like Call and returns its result as a T
+func Do[T any](ctx context.Context, api *TelegramBotAPI, methodName string, params interface{}) (T, error) {
+	var result T
+	err := api.Call(ctx, methodName, params, &result)
+	return result, err
+}
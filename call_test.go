@@ -0,0 +1,59 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCall(t *testing.T) {
+	f := newFakeBot(t)
+	f.handle("getChatMemberCount", func(params map[string]interface{}) interface{} {
+		return fakeInt(params, "chat_id") % 1000
+	})
+	api := f.connect()
+
+	n, err := Do[int](context.Background(), api, "getChatMemberCount", map[string]interface{}{"chat_id": 1042})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 42 {
+		t.Errorf("got %d, want 42", n)
+	}
+
+	if err = api.Call(context.Background(), "getChatMemberCount", map[string]interface{}{"chat_id": 1}, nil); err != nil {
+		t.Error(err)
+	}
+
+	if _, err = Do[model.User](context.Background(), api, "unknownMethod", nil); err == nil {
+		t.Error("no error for a method failing on the server")
+	}
+}
+
+func TestCallCanceled(t *testing.T) {
+	f := newFakeBot(t)
+	release := make(chan struct{})
+	f.handle("slowMethod", func(map[string]interface{}) interface{} {
+		<-release
+		return map[string]interface{}{"id": 1, "is_bot": false, "first_name": "Late"}
+	})
+	api := f.connect()
+	// the handler must return before the fake server is closed
+	t.Cleanup(func() { close(release) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var result model.User
+	if err := api.Call(ctx, "slowMethod", nil, &result); err != context.DeadlineExceeded {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// the abandoned request completes after Call returned, without writing to result
+	release <- struct{}{}
+	time.Sleep(20 * time.Millisecond)
+	if result.FirstName != "" {
+		t.Errorf("result was written after Call returned: %+v", result)
+	}
+}
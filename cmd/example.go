@@ -38,7 +38,7 @@ func main() {
 				}
 
 				// -> simple echo bot
-				msg, err := api.SendMessage(model.NewRecipientFromChat(val.Message.Chat), *val.Message.Text)
+				msg, err := api.SendMessage(val.Message.Chat.ID, *val.Message.Text)
 
 				//or
 				//msg, err := api.SendMessage(model.NewChatRecipient(val.Message.Chat.Id), *val.Message.Text)
@@ -0,0 +1,42 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+)
+
+// SyncMyCommands makes sure the bots commands for the scope and language of the list are the ones given.
+// The current commands are retrieved first, and only updated if they differ, which makes this suitable for calling at
+// every startup. An empty list deletes the commands for the scope and language.
+// It returns whether the commands were changed.
+func (api *TelegramBotAPI) SyncMyCommands(oc *model.OutgoingCommands) (bool, error) {
+	current, err := api.GetMyCommands(oc.Scope, oc.LanguageCode)
+	if err != nil {
+		return false, err
+	}
+
+	if commandsEqual(current.Commands, oc.Commands) {
+		return false, nil
+	}
+
+	if len(oc.Commands) == 0 {
+		_, err = api.DeleteMyCommands(oc.Scope, oc.LanguageCode)
+	} else {
+		_, err = api.SetMyCommands(oc)
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func commandsEqual(a, b []model.BotCommand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"testing"
+)
+
+func TestSyncMyCommands(t *testing.T) {
+	f := newFakeBot(t)
+	f.handle("getMyCommands", func(map[string]interface{}) interface{} {
+		return []interface{}{map[string]interface{}{"command": "start", "description": "Start the bot"}}
+	})
+	for _, method := range []string{"setMyCommands", "deleteMyCommands"} {
+		f.handle(method, func(map[string]interface{}) interface{} { return true })
+	}
+	api := f.connect()
+
+	scope := model.NewCommandScopeChat(model.NewChatRecipient(-100))
+	tests := []struct {
+		name        string
+		commands    *model.OutgoingCommands
+		wantChanged bool
+		wantMethod  string // method called after getMyCommands, empty if none
+	}{
+		{"equal", model.NewOutgoingCommands().AddCommand("start", "Start the bot"), false, ""},
+		{"different", model.NewOutgoingCommands().AddCommand("start", "Start the bot").AddCommand("help", "Get help"), true, "SetMyCommands"},
+		{"empty", model.NewOutgoingCommands(), true, "DeleteMyCommands"},
+	}
+
+	for _, tt := range tests {
+		f.mu.Lock()
+		f.calls = nil
+		f.mu.Unlock()
+
+		changed, err := api.SyncMyCommands(tt.commands.SetScope(scope).SetLanguageCode("de"))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if changed != tt.wantChanged {
+			t.Errorf("%s: got changed %v, want %v", tt.name, changed, tt.wantChanged)
+		}
+
+		f.mu.Lock()
+		calls := f.calls
+		f.mu.Unlock()
+		want := []string{"GetMyCommands"}
+		if tt.wantMethod != "" {
+			want = append(want, tt.wantMethod)
+		}
+		if len(calls) != len(want) {
+			t.Errorf("%s: got %d calls, want %v", tt.name, len(calls), want)
+			continue
+		}
+		for i, c := range calls {
+			if c.Method != want[i] {
+				t.Errorf("%s: call %d is %s, want %s", tt.name, i, c.Method, want[i])
+			}
+			s, _ := c.Params["scope"].(map[string]interface{})
+			if s["type"] != "chat" || fakeInt(s, "chat_id") != -100 || c.Params["language_code"] != "de" {
+				t.Errorf("%s: unexpected %s parameters %v", tt.name, c.Method, c.Params)
+			}
+		}
+		if commands, _ := calls[len(calls)-1].Params["commands"].([]interface{}); tt.wantMethod == "SetMyCommands" && len(commands) != 2 {
+			t.Errorf("%s: got commands %v", tt.name, calls[len(calls)-1].Params["commands"])
+		}
+	}
+}
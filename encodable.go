@@ -8,8 +8,20 @@ type encodable interface {
 	GetQueryString() model.Querystring
 }
 
+// uploadable is implemented by requests that may contain files to upload
+type uploadable interface {
+	encodable
+	GetFiles() map[string]string
+}
+
 type emptyEncodable struct{}
 
 func (emptyEncodable) GetQueryString() model.Querystring {
 	return model.Querystring(map[string]string{})
 }
+
+type querystringEncodable model.Querystring
+
+func (q querystringEncodable) GetQueryString() model.Querystring {
+	return model.Querystring(q)
+}
@@ -4,3 +4,12 @@ type file struct {
 	fieldName string
 	path      string
 }
+
+// filesFrom creates the files to upload from a map of field names to file paths
+func filesFrom(paths map[string]string) []file {
+	var toReturn []file
+	for fieldName, path := range paths {
+		toReturn = append(toReturn, file{fieldName: fieldName, path: path})
+	}
+	return toReturn
+}
@@ -0,0 +1,68 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+)
+
+// A JoinRequestDecision is the outcome of a JoinRequestPolicy
+type JoinRequestDecision int
+
+// JoinRequestDecisions
+const (
+	JoinRequestApprove JoinRequestDecision = iota // approve the request
+	JoinRequestDecline                            // decline the request
+	JoinRequestDefer                              // decide later, by calling ApproveChatJoinRequest or DeclineChatJoinRequest
+)
+
+// A JoinRequestPolicy decides about join requests.
+// To ask the user some questions first, a policy can send them messages using the UserChatID of the request and
+// defer the decision.
+type JoinRequestPolicy interface {
+	Decide(api *TelegramBotAPI, request *model.ChatJoinRequest) (JoinRequestDecision, error)
+}
+
+// JoinRequestPolicyFunc is an adapter to use ordinary functions as JoinRequestPolicies
+type JoinRequestPolicyFunc func(api *TelegramBotAPI, request *model.ChatJoinRequest) (JoinRequestDecision, error)
+
+// Decide calls f(api, request)
+func (f JoinRequestPolicyFunc) Decide(api *TelegramBotAPI, request *model.ChatJoinRequest) (JoinRequestDecision, error) {
+	return f(api, request)
+}
+
+// A JoinRequestHandler applies a JoinRequestPolicy to incoming join requests
+type JoinRequestHandler struct {
+	api    *TelegramBotAPI
+	policy JoinRequestPolicy
+}
+
+// NewJoinRequestHandler creates a new JoinRequestHandler using the policy
+func NewJoinRequestHandler(api *TelegramBotAPI, policy JoinRequestPolicy) *JoinRequestHandler {
+	return &JoinRequestHandler{
+		api:    api,
+		policy: policy,
+	}
+}
+
+// Handle handles the join request contained in the update, if any.
+// It returns whether the update contained a join request, and any error that occurred deciding about or answering
+// the request.
+func (h *JoinRequestHandler) Handle(update *model.Update) (bool, error) {
+	if update.ChatJoinRequest == nil {
+		return false, nil
+	}
+	request := update.ChatJoinRequest
+
+	decision, err := h.policy.Decide(h.api, request)
+	if err != nil {
+		return true, err
+	}
+
+	recipient := model.NewRecipientFromChat(request.Chat)
+	switch decision {
+	case JoinRequestApprove:
+		_, err = h.api.ApproveChatJoinRequest(recipient, request.From.ID)
+	case JoinRequestDecline:
+		_, err = h.api.DeclineChatJoinRequest(recipient, request.From.ID)
+	}
+	return true, err
+}
@@ -0,0 +1,74 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"errors"
+	"testing"
+)
+
+func TestJoinRequestHandler(t *testing.T) {
+	errPolicy := errors.New("policy failed")
+	tests := []struct {
+		name       string
+		decision   JoinRequestDecision
+		err        error
+		wantMethod string // method called to answer the request, empty if none
+	}{
+		{"approve", JoinRequestApprove, nil, "ApproveChatJoinRequest"},
+		{"decline", JoinRequestDecline, nil, "DeclineChatJoinRequest"},
+		{"defer", JoinRequestDefer, nil, ""},
+		{"error", JoinRequestApprove, errPolicy, ""},
+	}
+
+	for _, tt := range tests {
+		f := newFakeBot(t)
+		for _, method := range []string{"approveChatJoinRequest", "declineChatJoinRequest"} {
+			f.handle(method, func(map[string]interface{}) interface{} { return true })
+		}
+		api := f.connect()
+
+		var decided *model.ChatJoinRequest
+		h := NewJoinRequestHandler(api, JoinRequestPolicyFunc(func(_ *TelegramBotAPI, request *model.ChatJoinRequest) (JoinRequestDecision, error) {
+			decided = request
+			return tt.decision, tt.err
+		}))
+
+		request := &model.ChatJoinRequest{Chat: model.Chat{ID: -100, Type: "supergroup"}, From: model.User{ID: fakeUserID}}
+		handled, err := h.Handle(&model.Update{ChatJoinRequest: request})
+		if !handled || err != tt.err {
+			t.Errorf("%s: got handled %v and error %v", tt.name, handled, err)
+		}
+		if decided != request {
+			t.Errorf("%s: policy got request %+v", tt.name, decided)
+		}
+
+		f.mu.Lock()
+		calls := f.calls
+		f.mu.Unlock()
+		want := []string{"GetMe"}
+		if tt.wantMethod != "" {
+			want = append(want, tt.wantMethod)
+		}
+		if len(calls) != len(want) || calls[len(calls)-1].Method != want[len(want)-1] {
+			t.Errorf("%s: got calls %v, want %v", tt.name, calls, want)
+			continue
+		}
+		if tt.wantMethod != "" {
+			params := calls[1].Params
+			if fakeInt(params, "chat_id") != -100 || fakeInt(params, "user_id") != fakeUserID {
+				t.Errorf("%s: unexpected %s parameters %v", tt.name, tt.wantMethod, params)
+			}
+		}
+	}
+}
+
+func TestJoinRequestHandlerOtherUpdate(t *testing.T) {
+	h := NewJoinRequestHandler(nil, JoinRequestPolicyFunc(func(*TelegramBotAPI, *model.ChatJoinRequest) (JoinRequestDecision, error) {
+		t.Error("policy was asked about an update without a join request")
+		return JoinRequestDefer, nil
+	}))
+
+	if handled, err := h.Handle(&model.Update{Message: model.Message{}}); handled || err != nil {
+		t.Errorf("got handled %v and error %v", handled, err)
+	}
+}
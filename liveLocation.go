@@ -0,0 +1,107 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"errors"
+	"sync"
+	"time"
+)
+
+// A LiveLocationSession keeps a live location message up to date.
+// Create a new session by calling StartLiveLocation.
+type LiveLocationSession struct {
+	Message model.Message // the live location message
+	Errors  chan error    // a channel providing errors that occur while updating the location, closed when the session ends
+	api     *TelegramBotAPI
+	closed  chan struct{}
+	done    chan struct{}
+	once    sync.Once
+	stopErr error
+}
+
+// StartLiveLocation sends a live location and keeps it up to date with the locations received from the updates
+// channel.
+// Updates are not periodic: every location received is sent as soon as it arrives, so the sender of the updates
+// controls how often the live location is edited.
+// The live period must be set on the outgoing location, see OutgoingLocation.SetLivePeriod.
+// The session ends when Stop is called, the updates channel is closed or the live period expires. In the first two
+// cases, the live location is stopped explicitly.
+// Errors that occur while updating the location are put into the Errors channel of the session, which should be
+// drained until it is closed.
+func (api *TelegramBotAPI) StartLiveLocation(ol *model.OutgoingLocation, updates <-chan model.Location) (*LiveLocationSession, error) {
+	if ol.LivePeriod == 0 {
+		return nil, errors.New("tbotapi: live period not set")
+	}
+
+	resp, err := api.SendLocation(ol)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &LiveLocationSession{
+		Message: resp.Message,
+		Errors:  make(chan error),
+		api:     api,
+		closed:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.run(updates, time.Duration(ol.LivePeriod)*time.Second)
+
+	return s, nil
+}
+
+// Done returns a channel that is closed when the session has ended
+func (s *LiveLocationSession) Done() <-chan struct{} {
+	return s.done
+}
+
+// Stop ends the session and stops the live location, if it has not expired yet.
+// Stop blocks until the session has ended and returns the error that occurred stopping the live location, if any.
+func (s *LiveLocationSession) Stop() error {
+	s.once.Do(func() {
+		close(s.closed)
+	})
+	<-s.done
+	return s.stopErr
+}
+
+func (s *LiveLocationSession) run(updates <-chan model.Location, period time.Duration) {
+	defer func() {
+		close(s.Errors)
+		close(s.done)
+	}()
+
+	recipient := model.NewRecipientFromChat(s.Message.Chat)
+	expired := time.After(period)
+
+	for {
+		select {
+		case <-s.closed:
+			s.stop(recipient)
+			return
+		case <-expired:
+			return
+		case location, ok := <-updates:
+			if !ok {
+				s.stop(recipient)
+				return
+			}
+
+			_, err := s.api.EditMessageLiveLocation(model.NewOutgoingLiveLocationEditFromLocation(recipient, s.Message.ID, location))
+			if err != nil {
+				select {
+				case s.Errors <- err:
+				case <-s.closed:
+					s.stop(recipient)
+					return
+				case <-expired:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *LiveLocationSession) stop(recipient model.Recipient) {
+	_, s.stopErr = s.api.StopMessageLiveLocation(recipient, s.Message.ID)
+}
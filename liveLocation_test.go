@@ -0,0 +1,151 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newLiveLocationBot creates a fakeBot serving the live location methods.
+// Edits fail if failEdits is set.
+func newLiveLocationBot(t *testing.T, failEdits bool) *fakeBot {
+	f := newFakeBot(t)
+	message := func(params map[string]interface{}) interface{} {
+		return map[string]interface{}{"message_id": 7, "date": 1700000000,
+			"chat":     map[string]interface{}{"id": fakeChatID, "type": "private"},
+			"location": map[string]interface{}{"latitude": params["latitude"], "longitude": params["longitude"]}}
+	}
+	f.handle("sendLocation", message)
+	f.handle("editMessageLiveLocation", func(params map[string]interface{}) interface{} {
+		if failEdits {
+			return fakeError{Code: 400, Description: "Bad Request: message can't be edited"}
+		}
+		return message(params)
+	})
+	f.handle("stopMessageLiveLocation", message)
+	return f
+}
+
+// methods returns the names of the methods called, in order
+func (f *fakeBot) methods() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var toReturn []string
+	for _, c := range f.calls {
+		toReturn = append(toReturn, c.Method)
+	}
+	return toReturn
+}
+
+// waitDone waits for the session to end and its Errors channel to be closed
+func waitDone(t *testing.T, s *LiveLocationSession, timeout time.Duration) {
+	t.Helper()
+	select {
+	case <-s.Done():
+	case <-time.After(timeout):
+		t.Fatal("session did not end")
+	}
+	select {
+	case err, ok := <-s.Errors:
+		if ok {
+			t.Errorf("got error %v, want Errors to be closed", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Errors was not closed")
+	}
+}
+
+func TestLiveLocationStop(t *testing.T) {
+	f := newLiveLocationBot(t, false)
+	api := f.connect()
+
+	updates := make(chan model.Location)
+	s, err := api.StartLiveLocation(model.NewOutgoingLocation(model.NewChatRecipient(fakeChatID), 52.5, 13.25).SetLivePeriod(60), updates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coordinates := []model.Location{{Latitude: 52.51, Longitude: 13.26}, {Latitude: 52.52, Longitude: 13.27}, {Latitude: 52.53, Longitude: 13.28}}
+	for _, location := range coordinates {
+		updates <- location
+	}
+	if err = s.Stop(); err != nil {
+		t.Error(err)
+	}
+	waitDone(t, s, time.Second)
+
+	// every coordinate is one edit, followed by stopping the live location
+	want := []string{"getMe", "sendLocation", "editMessageLiveLocation", "editMessageLiveLocation", "editMessageLiveLocation", "stopMessageLiveLocation"}
+	got := f.methods()
+	if len(got) != len(want) {
+		t.Fatalf("got calls %v, want %v", got, want)
+	}
+	for i := range want {
+		if !strings.EqualFold(got[i], want[i]) {
+			t.Fatalf("got calls %v, want %v", got, want)
+		}
+	}
+
+	for i, c := range f.callsTo("editMessageLiveLocation") {
+		lat, _ := c.Params["latitude"].(json.Number).Float64()
+		lon, _ := c.Params["longitude"].(json.Number).Float64()
+		if lat != coordinates[i].Latitude || lon != coordinates[i].Longitude || fakeInt(c.Params, "message_id") != 7 {
+			t.Errorf("edit %d: got %v", i, c.Params)
+		}
+	}
+
+	// stopping again does nothing
+	if err = s.Stop(); err != nil || len(f.callsTo("stopMessageLiveLocation")) != 1 {
+		t.Errorf("second Stop: got error %v after %d calls", err, len(f.callsTo("stopMessageLiveLocation")))
+	}
+}
+
+func TestLiveLocationUpdatesClosed(t *testing.T) {
+	f := newLiveLocationBot(t, false)
+	api := f.connect()
+
+	updates := make(chan model.Location)
+	s, err := api.StartLiveLocation(model.NewOutgoingLocation(model.NewChatRecipient(fakeChatID), 52.5, 13.25).SetLivePeriod(60), updates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updates <- model.Location{Latitude: 52.51, Longitude: 13.26}
+	close(updates)
+	waitDone(t, s, time.Second)
+
+	if len(f.callsTo("editMessageLiveLocation")) != 1 || len(f.callsTo("stopMessageLiveLocation")) != 1 {
+		t.Errorf("got calls %v", f.methods())
+	}
+}
+
+func TestLiveLocationExpiredWhileReportingError(t *testing.T) {
+	f := newLiveLocationBot(t, true)
+	api := f.connect()
+
+	updates := make(chan model.Location)
+	s, err := api.StartLiveLocation(model.NewOutgoingLocation(model.NewChatRecipient(fakeChatID), 52.5, 13.25).SetLivePeriod(1), updates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the edit fails, nobody receives the error until the live period expired
+	updates <- model.Location{Latitude: 52.51, Longitude: 13.26}
+	waitDone(t, s, 3*time.Second)
+
+	// the live location expired, it is not stopped explicitly
+	if len(f.callsTo("editMessageLiveLocation")) != 1 || len(f.callsTo("stopMessageLiveLocation")) != 0 {
+		t.Errorf("got calls %v", f.methods())
+	}
+}
+
+func TestStartLiveLocationWithoutPeriod(t *testing.T) {
+	api := newLiveLocationBot(t, false).connect()
+
+	if _, err := api.StartLiveLocation(model.NewOutgoingLocation(model.NewChatRecipient(fakeChatID), 52.5, 13.25), nil); err == nil {
+		t.Error("no error for a location without live period")
+	}
+}
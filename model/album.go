@@ -0,0 +1,7 @@
+package model
+
+// Album represents a group of messages that were sent together as a media group
+type Album struct {
+	MediaGroupID string    // the ID of the media group
+	Messages     []Message // the messages of the album, ordered by their ID
+}
@@ -0,0 +1,12 @@
+package model
+
+// Animation represents an animation file, i.e. a GIF or H.264/MPEG-4 AVC video without sound
+type Animation struct {
+	FileBase
+	Width     int        `json:"width"`
+	Height    int        `json:"height"`
+	Duration  int        `json:"duration"`
+	Thumbnail *PhotoSize `json:"thumbnail"`
+	Name      *string    `json:"file_name"`
+	MimeType  *string    `json:"mime_type"`
+}
@@ -2,7 +2,14 @@ package model
 
 // BaseResponse contains the basic fields contained in every API response
 type BaseResponse struct {
-	Ok          bool   `json:"ok"`
-	Description string `json:"description"`
-	ErrorCode   int    `json:"error_code"`
+	Ok          bool                `json:"ok"`
+	Description string              `json:"description"`
+	ErrorCode   int                 `json:"error_code"`
+	Parameters  *ResponseParameters `json:"parameters"`
+}
+
+// ResponseParameters contains information about why a request was unsuccessful
+type ResponseParameters struct {
+	MigrateToChatID *int64 `json:"migrate_to_chat_id"` // the group has been migrated to a supergroup with this ID
+	RetryAfter      *int   `json:"retry_after"`        // the number of seconds left to wait before the request can be repeated
 }
@@ -0,0 +1,102 @@
+package model
+
+// BotCommandsResponse represents the response sent by the API on a GetMyCommands request
+type BotCommandsResponse struct {
+	BaseResponse
+	Commands []BotCommand `json:"result"`
+}
+
+// BotCommand represents a bot command shown in the command menu of clients
+type BotCommand struct {
+	Command     string `json:"command"`     // text of the command without the leading slash, 1-32 lowercase characters, digits and underscores
+	Description string `json:"description"` // description of the command, 1-256 characters
+}
+
+// BotCommandScopeType is the type of a BotCommandScope
+type BotCommandScopeType string
+
+// Represents all the possible BotCommandScopeTypes, see https://core.telegram.org/bots/api#botcommandscope
+const (
+	ScopeDefault               BotCommandScopeType = "default"
+	ScopeAllPrivateChats       BotCommandScopeType = "all_private_chats"
+	ScopeAllGroupChats         BotCommandScopeType = "all_group_chats"
+	ScopeAllChatAdministrators BotCommandScopeType = "all_chat_administrators"
+	ScopeChat                  BotCommandScopeType = "chat"
+	ScopeChatAdministrators    BotCommandScopeType = "chat_administrators"
+	ScopeChatMember            BotCommandScopeType = "chat_member"
+)
+
+// BotCommandScope represents the users and chats a list of bot commands applies to
+type BotCommandScope struct {
+	Type   BotCommandScopeType `json:"type"`
+	ChatID *Recipient          `json:"chat_id,omitempty"`
+	UserID int64               `json:"user_id,omitempty"`
+}
+
+// NewCommandScopeDefault creates a scope covering all chats without more specific commands
+func NewCommandScopeDefault() *BotCommandScope {
+	return &BotCommandScope{Type: ScopeDefault}
+}
+
+// NewCommandScopeAllPrivateChats creates a scope covering all private chats
+func NewCommandScopeAllPrivateChats() *BotCommandScope {
+	return &BotCommandScope{Type: ScopeAllPrivateChats}
+}
+
+// NewCommandScopeAllGroupChats creates a scope covering all group and supergroup chats
+func NewCommandScopeAllGroupChats() *BotCommandScope {
+	return &BotCommandScope{Type: ScopeAllGroupChats}
+}
+
+// NewCommandScopeAllChatAdministrators creates a scope covering all group and supergroup chat administrators
+func NewCommandScopeAllChatAdministrators() *BotCommandScope {
+	return &BotCommandScope{Type: ScopeAllChatAdministrators}
+}
+
+// NewCommandScopeChat creates a scope covering a specific chat
+func NewCommandScopeChat(recipient Recipient) *BotCommandScope {
+	return &BotCommandScope{Type: ScopeChat, ChatID: &recipient}
+}
+
+// NewCommandScopeChatAdministrators creates a scope covering all administrators of a specific group or supergroup
+func NewCommandScopeChatAdministrators(recipient Recipient) *BotCommandScope {
+	return &BotCommandScope{Type: ScopeChatAdministrators, ChatID: &recipient}
+}
+
+// NewCommandScopeChatMember creates a scope covering a specific member of a group or supergroup
+func NewCommandScopeChatMember(recipient Recipient, userID int64) *BotCommandScope {
+	return &BotCommandScope{Type: ScopeChatMember, ChatID: &recipient, UserID: userID}
+}
+
+// OutgoingCommands represents an outgoing list of bot commands for a scope and language
+type OutgoingCommands struct {
+	Commands     []BotCommand     `json:"commands"`
+	Scope        *BotCommandScope `json:"scope,omitempty"`
+	LanguageCode string           `json:"language_code,omitempty"`
+}
+
+// NewOutgoingCommands creates a new list of bot commands, up to 100 commands can be specified
+func NewOutgoingCommands(commands ...BotCommand) *OutgoingCommands {
+	return &OutgoingCommands{
+		Commands: commands,
+	}
+}
+
+// AddCommand adds a command to the list
+func (oc *OutgoingCommands) AddCommand(command, description string) *OutgoingCommands {
+	oc.Commands = append(oc.Commands, BotCommand{Command: command, Description: description})
+	return oc
+}
+
+// SetScope sets the scope the commands apply to, defaults to NewCommandScopeDefault (optional)
+func (oc *OutgoingCommands) SetScope(to *BotCommandScope) *OutgoingCommands {
+	oc.Scope = to
+	return oc
+}
+
+// SetLanguageCode sets the two-letter ISO 639-1 language code of the users the commands apply to (optional)
+// If empty, the commands apply to all users in the scope without dedicated commands for their language.
+func (oc *OutgoingCommands) SetLanguageCode(to string) *OutgoingCommands {
+	oc.LanguageCode = to
+	return oc
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBotCommandScopeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope *BotCommandScope
+		want  string
+	}{
+		{"default", NewCommandScopeDefault(), `{"type":"default"}`},
+		{"chat", NewCommandScopeChat(NewChatRecipient(-100)), `{"type":"chat","chat_id":-100}`},
+		{"channel", NewCommandScopeChat(NewChannelRecipient("@channel")), `{"type":"chat","chat_id":"@channel"}`},
+		{"chat administrators", NewCommandScopeChatAdministrators(NewChatRecipient(-100)), `{"type":"chat_administrators","chat_id":-100}`},
+		{"chat member", NewCommandScopeChatMember(NewChatRecipient(-100), 42), `{"type":"chat_member","chat_id":-100,"user_id":42}`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.scope)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, b, tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package model
+
+// BotNameResponse represents the response sent by the API on a GetMyName request
+type BotNameResponse struct {
+	BaseResponse
+	BotName BotName `json:"result"`
+}
+
+// BotName represents the name of the bot
+type BotName struct {
+	Name string `json:"name"`
+}
+
+// BotDescriptionResponse represents the response sent by the API on a GetMyDescription request
+type BotDescriptionResponse struct {
+	BaseResponse
+	BotDescription BotDescription `json:"result"`
+}
+
+// BotDescription represents the description of the bot, shown in empty chats with the bot
+type BotDescription struct {
+	Description string `json:"description"`
+}
+
+// BotShortDescriptionResponse represents the response sent by the API on a GetMyShortDescription request
+type BotShortDescriptionResponse struct {
+	BaseResponse
+	BotShortDescription BotShortDescription `json:"result"`
+}
+
+// BotShortDescription represents the short description of the bot, shown on its profile page
+type BotShortDescription struct {
+	ShortDescription string `json:"short_description"`
+}
+
+// MenuButtonKind is the type of a menu button
+type MenuButtonKind string
+
+// Represents all the possible MenuButtonKinds
+const (
+	MenuButtonCommands MenuButtonKind = "commands" // opens the list of commands of the bot
+	MenuButtonWebApp   MenuButtonKind = "web_app"  // launches a Web App
+	MenuButtonDefault  MenuButtonKind = "default"  // no specific button is set, the default is used
+)
+
+// MenuButtonResponse represents the response sent by the API on a GetChatMenuButton request
+type MenuButtonResponse struct {
+	BaseResponse
+	MenuButton MenuButton `json:"result"`
+}
+
+// MenuButton represents the menu button of the bot in private chats
+type MenuButton struct {
+	Type   MenuButtonKind `json:"type"`
+	Text   string         `json:"text,omitempty"`    // the text on the button, for MenuButtonWebApp
+	WebApp *WebAppInfo    `json:"web_app,omitempty"` // the Web App to launch, for MenuButtonWebApp
+}
+
+// NewCommandsMenuButton creates a new menu button opening the list of commands of the bot
+func NewCommandsMenuButton() *MenuButton {
+	return &MenuButton{
+		Type: MenuButtonCommands,
+	}
+}
+
+// NewWebAppMenuButton creates a new menu button launching the Web App at the URL
+func NewWebAppMenuButton(text, url string) *MenuButton {
+	return &MenuButton{
+		Type:   MenuButtonWebApp,
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// NewDefaultMenuButton creates a new menu button resetting to the default
+func NewDefaultMenuButton() *MenuButton {
+	return &MenuButton{
+		Type: MenuButtonDefault,
+	}
+}
+
+// AdministratorRightsResponse represents the response sent by the API on a GetMyDefaultAdministratorRights request
+type AdministratorRightsResponse struct {
+	BaseResponse
+	Rights ChatAdministratorRights `json:"result"`
+}
+
+// ChatAdministratorRights represents the rights of an administrator in a chat
+type ChatAdministratorRights struct {
+	IsAnonymous         bool `json:"is_anonymous"`           // whether the administrator's presence in the chat is hidden
+	CanManageChat       bool `json:"can_manage_chat"`        // whether the administrator can access the chat event log and more
+	CanDeleteMessages   bool `json:"can_delete_messages"`    // whether the administrator can delete messages of other users
+	CanManageVideoChats bool `json:"can_manage_video_chats"` // whether the administrator can manage video chats
+	CanRestrictMembers  bool `json:"can_restrict_members"`   // whether the administrator can restrict, ban or unban chat members
+	CanPromoteMembers   bool `json:"can_promote_members"`    // whether the administrator can add new administrators
+	CanChangeInfo       bool `json:"can_change_info"`        // whether the administrator can change the chat title, photo and other settings
+	CanInviteUsers      bool `json:"can_invite_users"`       // whether the administrator can invite new users to the chat
+	CanPostMessages     bool `json:"can_post_messages"`      // for channels, whether the administrator can post in the channel
+	CanEditMessages     bool `json:"can_edit_messages"`      // for channels, whether the administrator can edit messages of other users
+	CanPinMessages      bool `json:"can_pin_messages"`       // for groups and supergroups, whether the administrator can pin messages
+	CanManageTopics     bool `json:"can_manage_topics"`      // for supergroups, whether the administrator can create, rename, close, and reopen forum topics
+}
@@ -0,0 +1,13 @@
+package model
+
+// CallbackQuery represents an incoming callback query from a button of an inline keyboard.
+// If the button was a game button, GameShortName is set and the query should be answered with the URL of the game.
+type CallbackQuery struct {
+	ID              string   `json:"id"`                // unique query identifier
+	From            User     `json:"from"`              // user who pressed the button
+	Message         *Message `json:"message"`           // message with the button, if it was sent by the bot
+	InlineMessageID *string  `json:"inline_message_id"` // identifier of the message with the button, if it was sent in inline mode
+	ChatInstance    string   `json:"chat_instance"`     // global identifier of the chat the message with the button was sent to
+	Data            *string  `json:"data"`              // data associated with the button
+	GameShortName   *string  `json:"game_short_name"`   // short name of the game to be returned
+}
@@ -6,12 +6,13 @@ import (
 
 // Chat contains information about the chat a message originated from
 type Chat struct {
-	ID        int     `json:"id"`         // Unique identifier for this chat
-	Type      string  `json:"type"`       // Type of chat, can be either "private", "group" or "channel". Check Is(PrivateChat|GroupChat|Channel)() methods
+	ID        int64   `json:"id"`         // Unique identifier for this chat
+	Type      string  `json:"type"`       // Type of chat, can be either "private", "group", "supergroup" or "channel". Check Is(PrivateChat|GroupChat|Supergroup|Channel)() methods
 	Title     *string `json:"title"`      // Title for channels and group chats
 	Username  *string `json:"username"`   // Username for private chats and channels if available
 	FirstName *string `json:"first_name"` // First name of the other party in a private chat
 	LastName  *string `json:"last_name"`  // Last name of the other party in a private chat
+	IsForum   bool    `json:"is_forum"`   // Whether the supergroup has topics enabled
 }
 
 // IsPrivateChat checks if the chat is a private chat
@@ -24,6 +25,11 @@ func (c Chat) IsGroupChat() bool {
 	return c.Type == "group"
 }
 
+// IsSupergroup checks if the chat is a supergroup
+func (c Chat) IsSupergroup() bool {
+	return c.Type == "supergroup"
+}
+
 // IsChannel checks if the chat is a channel
 func (c Chat) IsChannel() bool {
 	return c.Type == "channel"
@@ -35,6 +41,8 @@ func (c Chat) String() string {
 		toReturn += " (P) "
 	} else if c.IsGroupChat() {
 		toReturn += " (G) "
+	} else if c.IsSupergroup() {
+		toReturn += " (S) "
 	} else {
 		toReturn += " (C) "
 	}
@@ -0,0 +1,83 @@
+package model
+
+// ChatMemberStatus is the status of a chat member
+type ChatMemberStatus string
+
+// Represents all the possible ChatMemberStatuses
+const (
+	MemberCreator       ChatMemberStatus = "creator"
+	MemberAdministrator ChatMemberStatus = "administrator"
+	MemberMember        ChatMemberStatus = "member"
+	MemberRestricted    ChatMemberStatus = "restricted"
+	MemberLeft          ChatMemberStatus = "left"
+	MemberKicked        ChatMemberStatus = "kicked"
+)
+
+// ChatMember contains information about one member of a chat.
+// Most fields are only present for some statuses, check the API documentation.
+type ChatMember struct {
+	Status              ChatMemberStatus `json:"status"`                 // the member's status in the chat
+	User                User             `json:"user"`                   // information about the user
+	IsAnonymous         *bool            `json:"is_anonymous"`           // for owners and administrators, whether the user's presence in the chat is hidden
+	CustomTitle         *string          `json:"custom_title"`           // for owners and administrators, custom title for this user
+	UntilDate           *int             `json:"until_date"`             // for restricted and kicked users, timestamp when restrictions will be lifted, 0 if forever
+	IsMember            *bool            `json:"is_member"`              // for restricted users, whether the user is a member of the chat
+	CanBeEdited         *bool            `json:"can_be_edited"`          // for administrators, whether the bot is allowed to edit administrator privileges of that user
+	CanManageChat       *bool            `json:"can_manage_chat"`        // for administrators, whether the administrator can access the chat event log and more
+	CanDeleteMessages   *bool            `json:"can_delete_messages"`    // for administrators, whether the administrator can delete messages of other users
+	CanRestrictMembers  *bool            `json:"can_restrict_members"`   // for administrators, whether the administrator can restrict, ban or unban chat members
+	CanPromoteMembers   *bool            `json:"can_promote_members"`    // for administrators, whether the administrator can add new administrators
+	CanChangeInfo       *bool            `json:"can_change_info"`        // whether the user can change the chat title, photo and other settings
+	CanInviteUsers      *bool            `json:"can_invite_users"`       // whether the user can invite new users to the chat
+	CanPinMessages      *bool            `json:"can_pin_messages"`       // whether the user can pin messages
+	CanManageTopics     *bool            `json:"can_manage_topics"`      // whether the user can create, rename, close, and reopen forum topics
+	CanPostMessages     *bool            `json:"can_post_messages"`      // for channel administrators, whether the administrator can post in the channel
+	CanEditMessages     *bool            `json:"can_edit_messages"`      // for channel administrators, whether the administrator can edit messages of other users
+	CanSendMessages     *bool            `json:"can_send_messages"`      // for restricted users, whether the user can send messages
+	CanManageVideoChats *bool            `json:"can_manage_video_chats"` // for administrators, whether the administrator can manage video chats
+}
+
+// IsPresent checks if the user is currently part of the chat
+func (cm ChatMember) IsPresent() bool {
+	switch cm.Status {
+	case MemberCreator, MemberAdministrator, MemberMember:
+		return true
+	case MemberRestricted:
+		return cm.IsMember != nil && *cm.IsMember
+	}
+	return false
+}
+
+// ChatMemberUpdated represents changes in the status of a chat member
+type ChatMemberUpdated struct {
+	Chat           Chat            `json:"chat"`             // chat the user belongs to
+	From           User            `json:"from"`             // performer of the action that resulted in the change
+	Date           int             `json:"date"`             // timestamp of the change
+	OldChatMember  ChatMember      `json:"old_chat_member"`  // previous information about the chat member
+	NewChatMember  ChatMember      `json:"new_chat_member"`  // new information about the chat member
+	InviteLink     *ChatInviteLink `json:"invite_link"`      // the invite link used by the user to join the chat, if any
+	ViaJoinRequest bool            `json:"via_join_request"` // whether the user joined the chat after sending a join request
+}
+
+// ChatInviteLink represents an invite link for a chat
+type ChatInviteLink struct {
+	InviteLink              string  `json:"invite_link"`                // the invite link
+	Creator                 User    `json:"creator"`                    // creator of the link
+	CreatesJoinRequest      bool    `json:"creates_join_request"`       // whether users joining via the link need to be approved by administrators
+	IsPrimary               bool    `json:"is_primary"`                 // whether the link is primary
+	IsRevoked               bool    `json:"is_revoked"`                 // whether the link is revoked
+	Name                    *string `json:"name"`                       // invite link name
+	ExpireDate              *int    `json:"expire_date"`                // timestamp when the link will expire or has expired
+	MemberLimit             *int    `json:"member_limit"`               // maximum number of users that can be members of the chat simultaneously after joining via this link
+	PendingJoinRequestCount *int    `json:"pending_join_request_count"` // number of pending join requests created using this link
+}
+
+// ChatJoinRequest represents a join request sent to a chat
+type ChatJoinRequest struct {
+	Chat       Chat            `json:"chat"`         // chat to which the request was sent
+	From       User            `json:"from"`         // user that sent the join request
+	UserChatID int64           `json:"user_chat_id"` // identifier of a private chat with the user, usable for five minutes
+	Date       int             `json:"date"`         // timestamp of the request
+	Bio        *string         `json:"bio"`          // bio of the user
+	InviteLink *ChatInviteLink `json:"invite_link"`  // the invite link used by the user to send the join request
+}
@@ -2,8 +2,9 @@ package model
 
 // Contact represents a phone contact
 type Contact struct {
-	PhoneNumber string `json:"phone_number"`
-	FirstName   string `json:"first_name"`
-	LastName    string `json:"last_name"`
-	ID          int    `json:"user_id"`
+	PhoneNumber string  `json:"phone_number"`
+	FirstName   string  `json:"first_name"`
+	LastName    string  `json:"last_name"`
+	ID          int64   `json:"user_id"`
+	VCard       *string `json:"vcard"`
 }
@@ -0,0 +1,20 @@
+package model
+
+// DiceEmoji is the emoji on which a dice throw animation is based
+type DiceEmoji string
+
+// Represents all the possible DiceEmojis, see https://core.telegram.org/bots/api#dice
+const (
+	DiceEmojiDice        DiceEmoji = "🎲" // values 1-6
+	DiceEmojiDarts       DiceEmoji = "🎯" // values 1-6
+	DiceEmojiBowling     DiceEmoji = "🎳" // values 1-6
+	DiceEmojiBasketball  DiceEmoji = "🏀" // values 1-5
+	DiceEmojiFootball    DiceEmoji = "⚽" // values 1-5
+	DiceEmojiSlotMachine DiceEmoji = "🎰" // values 1-64
+)
+
+// Dice represents an animated emoji that displays a random value
+type Dice struct {
+	Emoji DiceEmoji `json:"emoji"`
+	Value int       `json:"value"`
+}
@@ -3,7 +3,7 @@ package model
 // Document represents a general file
 type Document struct {
 	FileBase
-	Thumbnail PhotoSize `json:"thumb"`
+	Thumbnail PhotoSize `json:"thumbnail"`
 	Name      string    `json:"file_name"`
 	MimeType  string    `json:"mime_type"`
 }
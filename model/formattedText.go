@@ -0,0 +1,239 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormattedText is a builder for formatted message texts.
+// User-supplied strings can be passed to any of the methods, they are escaped as needed when rendering.
+// Render the text for a ParseMode with Render, or as plain text with entities with Entities.
+type FormattedText struct {
+	parts []textPart
+}
+
+type textPart struct {
+	typ      EntityType // empty for plain text
+	text     string
+	url      string
+	language string
+}
+
+// NewFormattedText creates a new, empty formatted text
+func NewFormattedText() *FormattedText {
+	return &FormattedText{}
+}
+
+// Text appends plain text
+func (ft *FormattedText) Text(text string) *FormattedText {
+	return ft.append(textPart{text: text})
+}
+
+// Textf appends plain text formatted according to a format specifier
+func (ft *FormattedText) Textf(format string, a ...interface{}) *FormattedText {
+	return ft.Text(fmt.Sprintf(format, a...))
+}
+
+// Bold appends bold text
+func (ft *FormattedText) Bold(text string) *FormattedText {
+	return ft.append(textPart{typ: EntityBold, text: text})
+}
+
+// Italic appends italic text
+func (ft *FormattedText) Italic(text string) *FormattedText {
+	return ft.append(textPart{typ: EntityItalic, text: text})
+}
+
+// Underline appends underlined text
+func (ft *FormattedText) Underline(text string) *FormattedText {
+	return ft.append(textPart{typ: EntityUnderline, text: text})
+}
+
+// Strikethrough appends strikethrough text
+func (ft *FormattedText) Strikethrough(text string) *FormattedText {
+	return ft.append(textPart{typ: EntityStrikethrough, text: text})
+}
+
+// Spoiler appends text that is hidden until tapped
+func (ft *FormattedText) Spoiler(text string) *FormattedText {
+	return ft.append(textPart{typ: EntitySpoiler, text: text})
+}
+
+// Code appends inline monowidth text
+func (ft *FormattedText) Code(text string) *FormattedText {
+	return ft.append(textPart{typ: EntityCode, text: text})
+}
+
+// Pre appends a monowidth block.
+// The language is optional and may be empty. It may only contain letters, digits and the characters "+-#._",
+// Render returns an error otherwise.
+func (ft *FormattedText) Pre(language, text string) *FormattedText {
+	return ft.append(textPart{typ: EntityPre, text: text, language: language})
+}
+
+// Link appends text that opens url when tapped
+func (ft *FormattedText) Link(text, url string) *FormattedText {
+	return ft.append(textPart{typ: EntityTextLink, text: text, url: url})
+}
+
+// Mention appends text that mentions the user with the given ID
+func (ft *FormattedText) Mention(text string, userID int64) *FormattedText {
+	return ft.Link(text, fmt.Sprintf("tg://user?id=%d", userID))
+}
+
+func (ft *FormattedText) append(part textPart) *FormattedText {
+	if part.text != "" {
+		ft.parts = append(ft.parts, part)
+	}
+	return ft
+}
+
+// String returns the text without any formatting
+func (ft *FormattedText) String() string {
+	toReturn := ""
+	for _, part := range ft.parts {
+		toReturn += part.text
+	}
+	return toReturn
+}
+
+// Entities returns the text without any formatting, together with the entities describing the formatting.
+// Use this to send formatted text with ModeDefault.
+func (ft *FormattedText) Entities() (string, []MessageEntity) {
+	var entities []MessageEntity
+	offset := 0
+
+	for _, part := range ft.parts {
+		length := utf16Count(part.text)
+		if part.typ != "" {
+			entity := MessageEntity{
+				Type:   part.typ,
+				Offset: offset,
+				Length: length,
+			}
+			if part.url != "" {
+				url := part.url
+				entity.URL = &url
+			}
+			if part.language != "" {
+				language := part.language
+				entity.Language = &language
+			}
+			entities = append(entities, entity)
+		}
+		offset += length
+	}
+
+	return ft.String(), entities
+}
+
+// Render renders the text for the given ParseMode.
+// ModeDefault renders the text without any formatting.
+// Legacy Markdown is not supported, as it cannot represent arbitrary text, an error is returned for it and any
+// unknown ParseMode.
+func (ft *FormattedText) Render(mode ParseMode) (string, error) {
+	for _, part := range ft.parts {
+		if !validLanguage(part.language) {
+			return "", fmt.Errorf("tbotapi: invalid code block language %q", part.language)
+		}
+	}
+
+	switch mode {
+	case ModeDefault:
+		return ft.String(), nil
+	case ModeHTML:
+		return ft.render(renderHTML), nil
+	case ModeMarkdownV2:
+		return ft.render(renderMarkdownV2), nil
+	}
+	return "", fmt.Errorf("tbotapi: cannot render formatted text with parse mode %q", string(mode))
+}
+
+// render renders all parts using renderPart, which is given the part following the part to render, if any
+func (ft *FormattedText) render(renderPart func(part textPart, next *textPart) string) string {
+	b := strings.Builder{}
+	for i, part := range ft.parts {
+		var next *textPart
+		if i+1 < len(ft.parts) {
+			next = &ft.parts[i+1]
+		}
+		b.WriteString(renderPart(part, next))
+	}
+	return b.String()
+}
+
+// validLanguage checks whether the language of a code block can be rendered without escaping
+func validLanguage(language string) bool {
+	for _, r := range language {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("+-#._", r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func renderHTML(part textPart, _ *textPart) string {
+	text := EscapeHTML(part.text)
+	switch part.typ {
+	case EntityBold:
+		return "<b>" + text + "</b>"
+	case EntityItalic:
+		return "<i>" + text + "</i>"
+	case EntityUnderline:
+		return "<u>" + text + "</u>"
+	case EntityStrikethrough:
+		return "<s>" + text + "</s>"
+	case EntitySpoiler:
+		return "<tg-spoiler>" + text + "</tg-spoiler>"
+	case EntityCode:
+		return "<code>" + text + "</code>"
+	case EntityPre:
+		if part.language != "" {
+			return "<pre><code class=\"language-" + EscapeHTML(part.language) + "\">" + text + "</code></pre>"
+		}
+		return "<pre>" + text + "</pre>"
+	case EntityTextLink:
+		return "<a href=\"" + EscapeHTML(part.url) + "\">" + text + "</a>"
+	}
+	return text
+}
+
+func renderMarkdownV2(part textPart, next *textPart) string {
+	switch part.typ {
+	case EntityCode:
+		return "`" + markdownV2Code.Replace(part.text) + "`"
+	case EntityPre:
+		return "```" + part.language + "\n" + markdownV2Code.Replace(part.text) + "\n```"
+	}
+
+	text := EscapeMarkdownV2(part.text)
+	switch part.typ {
+	case EntityBold:
+		return "*" + text + "*"
+	case EntityItalic:
+		if next != nil && next.typ == EntityUnderline {
+			// Telegram ignores the carriage return, it keeps the following underline from being mistaken for the end
+			// of this
+			return "_" + text + "_\r"
+		}
+		return "_" + text + "_"
+	case EntityUnderline:
+		return "__" + text + "__"
+	case EntityStrikethrough:
+		return "~" + text + "~"
+	case EntitySpoiler:
+		return "||" + text + "||"
+	case EntityTextLink:
+		return "[" + text + "](" + markdownV2URL.Replace(part.url) + ")"
+	}
+	return text
+}
+
+// utf16Count returns the number of UTF-16 code units needed to encode s
+func utf16Count(s string) int {
+	count := 0
+	for _, r := range s {
+		count += utf16Len(r)
+	}
+	return count
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	ft := NewFormattedText().Text("a < b ").Bold("*bold*")
+
+	tests := []struct {
+		mode    ParseMode
+		want    string
+		wantErr bool
+	}{
+		{ModeDefault, "a < b *bold*", false},
+		{ModeHTML, "a &lt; b <b>*bold*</b>", false},
+		{ModeMarkdownV2, "a < b *\\*bold\\**", false},
+		{ModeMarkdown, "", true},
+		{ParseMode("unknown"), "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ft.Render(tt.mode)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: got error %v, want error %v", tt.mode, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestRenderParts(t *testing.T) {
+	tests := []struct {
+		name     string
+		ft       *FormattedText
+		html     string
+		v2       string
+		wantErrs bool
+	}{
+		{"link", NewFormattedText().Link("a_b", `https://example.com/(a)\b?c=<d>&e="f"`),
+			`<a href="https://example.com/(a)\b?c=&lt;d&gt;&amp;e=&quot;f&quot;">a_b</a>`,
+			`[a\_b](https://example.com/(a\)\\b?c=<d>&e="f")`, false},
+		{"pre", NewFormattedText().Pre("", "x := `a` \\ <b>"),
+			"<pre>x := `a` \\ &lt;b&gt;</pre>",
+			"```\nx := \\`a\\` \\\\ <b>\n```", false},
+		{"pre with language", NewFormattedText().Pre("c++", "a*b"),
+			`<pre><code class="language-c++">a*b</code></pre>`,
+			"```c++\na*b\n```", false},
+		{"pre with invalid language", NewFormattedText().Pre("go\n```", "x"), "", "", true},
+		{"mention", NewFormattedText().Mention("Vlad", 8589934592),
+			`<a href="tg://user?id=8589934592">Vlad</a>`,
+			`[Vlad](tg://user?id=8589934592)`, false},
+		{"spoiler", NewFormattedText().Spoiler("secret!"),
+			"<tg-spoiler>secret!</tg-spoiler>",
+			`||secret\!||`, false},
+		{"code", NewFormattedText().Code("a`b*c"),
+			"<code>a`b*c</code>",
+			"`a\\`b*c`", false},
+		{"italic", NewFormattedText().Italic("a").Bold("b"),
+			"<i>a</i><b>b</b>",
+			"_a_*b*", false},
+		{"italic before underline", NewFormattedText().Italic("a").Underline("b"),
+			"<i>a</i><u>b</u>",
+			"_a_\r__b__", false},
+		{"strikethrough", NewFormattedText().Strikethrough("a~b"),
+			"<s>a~b</s>",
+			`~a\~b~`, false},
+		{"reserved characters", NewFormattedText().Text("_*[]()~`>#+-=|{}.!\\<&\""),
+			"_*[]()~`&gt;#+-=|{}.!\\&lt;&amp;&quot;",
+			"\\_\\*\\[\\]\\(\\)\\~\\`\\>\\#\\+\\-\\=\\|\\{\\}\\.\\!\\\\<&\"", false},
+	}
+
+	for _, tt := range tests {
+		html, err := tt.ft.Render(ModeHTML)
+		if (err != nil) != tt.wantErrs || html != tt.html {
+			t.Errorf("%s: got HTML %q and error %v, want %q", tt.name, html, err, tt.html)
+		}
+		v2, err := tt.ft.Render(ModeMarkdownV2)
+		if (err != nil) != tt.wantErrs || v2 != tt.v2 {
+			t.Errorf("%s: got MarkdownV2 %q and error %v, want %q", tt.name, v2, err, tt.v2)
+		}
+	}
+}
+
+func TestFormattedTextEntities(t *testing.T) {
+	text, entities := NewFormattedText().Text("👍 ").Bold("bold").Text(" 👨‍👩‍👧 ").Link("é", "https://example.com").
+		Pre("go", "x").Entities()
+
+	if text != "👍 bold 👨‍👩‍👧 éx" {
+		t.Errorf("got text %q", text)
+	}
+
+	want := []struct {
+		typ            EntityType
+		offset, length int
+	}{
+		{EntityBold, 3, 4},
+		{EntityTextLink, 17, 1},
+		{EntityPre, 18, 1},
+	}
+	if len(entities) != len(want) {
+		t.Fatalf("got %d entities, want %d", len(entities), len(want))
+	}
+	for i, w := range want {
+		e := entities[i]
+		if e.Type != w.typ || e.Offset != w.offset || e.Length != w.length {
+			t.Errorf("entity %d: got %s at %d+%d, want %s at %d+%d", i, e.Type, e.Offset, e.Length, w.typ, w.offset, w.length)
+		}
+	}
+	if entities[1].URL == nil || *entities[1].URL != "https://example.com" {
+		t.Errorf("got URL %v", entities[1].URL)
+	}
+	if entities[2].Language == nil || *entities[2].Language != "go" {
+		t.Errorf("got language %v", entities[2].Language)
+	}
+}
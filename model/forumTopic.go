@@ -0,0 +1,95 @@
+package model
+
+// Forum topic icon colors, the only colors allowed when creating topics
+const (
+	TopicColorBlue   = 0x6FB9F0
+	TopicColorYellow = 0xFFD67E
+	TopicColorViolet = 0xCB86DB
+	TopicColorGreen  = 0x8EEE98
+	TopicColorRose   = 0xFF93B2
+	TopicColorRed    = 0xFB6F5F
+)
+
+// ForumTopicResponse represents the response sent by the API on a CreateForumTopic request
+type ForumTopicResponse struct {
+	BaseResponse
+	ForumTopic ForumTopic `json:"result"`
+}
+
+// ForumTopic represents a forum topic
+type ForumTopic struct {
+	MessageThreadID   int     `json:"message_thread_id"`    // the ID of the topic
+	Name              string  `json:"name"`                 // the name of the topic
+	IconColor         int     `json:"icon_color"`           // the color of the topic icon in RGB format
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id"` // the custom emoji shown as the topic icon
+}
+
+// ForumTopicCreation represents a service message about a created forum topic
+type ForumTopicCreation struct {
+	Name              string  `json:"name"`                 // the name of the topic
+	IconColor         int     `json:"icon_color"`           // the color of the topic icon in RGB format
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id"` // the custom emoji shown as the topic icon
+}
+
+// ForumTopicEdit represents a service message about an edited forum topic
+type ForumTopicEdit struct {
+	Name              *string `json:"name"`                 // the new name of the topic, if it was edited
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id"` // the new custom emoji shown as the topic icon, if it was edited, empty if it was removed
+}
+
+// OutgoingForumTopic represents a forum topic to be created
+type OutgoingForumTopic struct {
+	Recipient         Recipient `json:"chat_id"`
+	Name              string    `json:"name"`
+	IconColor         int       `json:"icon_color,omitempty"`
+	IconCustomEmojiID string    `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// NewOutgoingForumTopic creates a new forum topic with the name, 1-128 characters
+func NewOutgoingForumTopic(recipient Recipient, name string) *OutgoingForumTopic {
+	return &OutgoingForumTopic{
+		Recipient: recipient,
+		Name:      name,
+	}
+}
+
+// SetIconColor sets the color of the topic icon, one of the TopicColor constants (optional)
+func (of *OutgoingForumTopic) SetIconColor(to int) *OutgoingForumTopic {
+	of.IconColor = to
+	return of
+}
+
+// SetIconCustomEmojiID sets the custom emoji shown as the topic icon (optional)
+func (of *OutgoingForumTopic) SetIconCustomEmojiID(to string) *OutgoingForumTopic {
+	of.IconCustomEmojiID = to
+	return of
+}
+
+// OutgoingForumTopicEdit represents an edit of a forum topic
+type OutgoingForumTopicEdit struct {
+	Recipient         Recipient `json:"chat_id"`
+	MessageThreadID   int       `json:"message_thread_id"`
+	Name              string    `json:"name,omitempty"`
+	IconCustomEmojiID *string   `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// NewOutgoingForumTopicEdit creates a new edit of the forum topic with ID messageThreadID.
+// Properties not set are kept.
+func NewOutgoingForumTopicEdit(recipient Recipient, messageThreadID int) *OutgoingForumTopicEdit {
+	return &OutgoingForumTopicEdit{
+		Recipient:       recipient,
+		MessageThreadID: messageThreadID,
+	}
+}
+
+// SetName sets the new name of the topic, 1-128 characters (optional)
+func (oe *OutgoingForumTopicEdit) SetName(to string) *OutgoingForumTopicEdit {
+	oe.Name = to
+	return oe
+}
+
+// SetIconCustomEmojiID sets the new custom emoji shown as the topic icon, the empty string removes the icon (optional)
+func (oe *OutgoingForumTopicEdit) SetIconCustomEmojiID(to string) *OutgoingForumTopicEdit {
+	oe.IconCustomEmojiID = &to
+	return oe
+}
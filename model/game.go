@@ -0,0 +1,24 @@
+package model
+
+// GameHighScoresResponse represents the response sent by the API on a GetGameHighScores request
+type GameHighScoresResponse struct {
+	BaseResponse
+	HighScores []GameHighScore `json:"result"`
+}
+
+// Game represents a game
+type Game struct {
+	Title        string           `json:"title"`         // title of the game
+	Description  string           `json:"description"`   // description of the game
+	Photo        []PhotoSize      `json:"photo"`         // photo that will be displayed in the game message in chats
+	Text         *string          `json:"text"`          // brief description of the game or high scores included in the game message
+	TextEntities *[]MessageEntity `json:"text_entities"` // special entities that appear in text
+	Animation    *Animation       `json:"animation"`     // animation that will be displayed in the game message in chats
+}
+
+// GameHighScore represents one row of the high scores table for a game
+type GameHighScore struct {
+	Position int  `json:"position"` // position in the high score table
+	User     User `json:"user"`     // the user
+	Score    int  `json:"score"`    // the score
+}
@@ -0,0 +1,51 @@
+package model
+
+// InlineKeyboardMarkup represents an inline keyboard that appears right next to the message it belongs to
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"` // slice of button rows
+}
+
+// InlineKeyboardButton represents one button of an inline keyboard.
+// Exactly one of the optional fields must be used.
+type InlineKeyboardButton struct {
+	Text         string        `json:"text"`                    // label text on the button
+	URL          string        `json:"url,omitempty"`           // URL to be opened when the button is pressed
+	CallbackData string        `json:"callback_data,omitempty"` // data to be sent in a callback query when the button is pressed
+	CallbackGame *CallbackGame `json:"callback_game,omitempty"` // game to be launched when the button is pressed, must be the first button in the first row
+	WebApp       *WebAppInfo   `json:"web_app,omitempty"`       // Web App to be launched when the button is pressed, only in private chats
+}
+
+// CallbackGame is a placeholder, it marks an InlineKeyboardButton that launches a game
+type CallbackGame struct{}
+
+// NewInlineKeyboardButtonURL creates a new button that opens url
+func NewInlineKeyboardButtonURL(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text: text,
+		URL:  url,
+	}
+}
+
+// NewInlineKeyboardButtonCallback creates a new button that sends a callback query with the data
+func NewInlineKeyboardButtonCallback(text, data string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:         text,
+		CallbackData: data,
+	}
+}
+
+// NewInlineKeyboardButtonWebApp creates a new button that launches the Web App at the URL
+func NewInlineKeyboardButtonWebApp(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// NewInlineKeyboardButtonGame creates a new button that launches the game of the message
+func NewInlineKeyboardButtonGame(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:         text,
+		CallbackGame: &CallbackGame{},
+	}
+}
@@ -0,0 +1,68 @@
+package model
+
+// InputMediaType is the type of an InputMedia
+type InputMediaType string
+
+// Represents all the InputMediaTypes that can be sent in a media group
+const (
+	InputMediaPhoto    InputMediaType = "photo"
+	InputMediaVideo    InputMediaType = "video"
+	InputMediaDocument InputMediaType = "document"
+)
+
+// InputMedia represents a photo, video or document to be sent as part of a media group
+type InputMedia struct {
+	Type            InputMediaType  `json:"type"`
+	Media           string          `json:"media"` // a file ID, URL or attach://<field name> for uploaded files
+	Caption         string          `json:"caption,omitempty"`
+	ParseMode       ParseMode       `json:"parse_mode,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	filePath        string
+}
+
+// NewInputMedia creates a new InputMedia for a file that is already on the Telegram servers
+func NewInputMedia(typ InputMediaType, fileID string) *InputMedia {
+	return &InputMedia{
+		Type:  typ,
+		Media: fileID,
+	}
+}
+
+// NewInputMediaUpload creates a new InputMedia for a file that is not yet on the Telegram servers
+func NewInputMediaUpload(typ InputMediaType, filePath string) *InputMedia {
+	return &InputMedia{
+		Type:     typ,
+		filePath: filePath,
+	}
+}
+
+// SetCaption sets a caption for the media (optional)
+func (im *InputMedia) SetCaption(to string) *InputMedia {
+	im.Caption = to
+	return im
+}
+
+// SetParseMode sets the ParseMode for the caption (optional)
+func (im *InputMedia) SetParseMode(to ParseMode) *InputMedia {
+	im.ParseMode = to
+	return im
+}
+
+// SetCaptionEntities sets the entities of the caption, instead of a ParseMode (optional)
+func (im *InputMedia) SetCaptionEntities(to []MessageEntity) *InputMedia {
+	im.CaptionEntities = to
+	return im
+}
+
+// SetFormattedCaption sets the caption to the formatted text.
+// The formatting is sent as entities, so the ParseMode is reset.
+func (im *InputMedia) SetFormattedCaption(to *FormattedText) *InputMedia {
+	im.Caption, im.CaptionEntities = to.Entities()
+	im.ParseMode = ModeDefault
+	return im
+}
+
+// IsUpload checks if the media is a file to be uploaded
+func (im *InputMedia) IsUpload() bool {
+	return im.filePath != ""
+}
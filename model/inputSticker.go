@@ -0,0 +1,172 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// InputSticker represents a sticker to be added to a sticker set
+type InputSticker struct {
+	Sticker   string        `json:"sticker"` // a file ID, URL or attach://<field name> for uploaded files
+	Format    StickerFormat `json:"format"`
+	EmojiList []string      `json:"emoji_list"`
+	Keywords  []string      `json:"keywords,omitempty"`
+	filePath  string
+}
+
+// NewInputSticker creates a new InputSticker for a file that is already on the Telegram servers.
+// One to twenty emoji must be associated with the sticker.
+func NewInputSticker(fileID string, format StickerFormat, emoji ...string) *InputSticker {
+	return &InputSticker{
+		Sticker:   fileID,
+		Format:    format,
+		EmojiList: emoji,
+	}
+}
+
+// NewInputStickerUpload creates a new InputSticker for a file that is not yet on the Telegram servers.
+// One to twenty emoji must be associated with the sticker.
+func NewInputStickerUpload(filePath string, format StickerFormat, emoji ...string) *InputSticker {
+	return &InputSticker{
+		Format:    format,
+		EmojiList: emoji,
+		filePath:  filePath,
+	}
+}
+
+// SetKeywords sets up to twenty search keywords for the sticker, for regular and custom emoji stickers (optional)
+func (is *InputSticker) SetKeywords(to ...string) *InputSticker {
+	is.Keywords = to
+	return is
+}
+
+// IsUpload checks if the sticker is a file to be uploaded
+func (is *InputSticker) IsUpload() bool {
+	return is.filePath != ""
+}
+
+// OutgoingStickerSet represents a new sticker set to be created
+type OutgoingStickerSet struct {
+	UserID          int64           `json:"user_id"`
+	Name            string          `json:"name"`
+	Title           string          `json:"title"`
+	Stickers        []*InputSticker `json:"stickers"`
+	StickerType     StickerKind     `json:"sticker_type,omitempty"`
+	NeedsRepainting bool            `json:"needs_repainting,omitempty"`
+}
+
+// NewOutgoingStickerSet creates a new sticker set owned by the user with ID userID.
+// The name must end in "_by_<bot username>" and can be used in t.me/addstickers/ links.
+func NewOutgoingStickerSet(userID int64, name, title string, stickers ...*InputSticker) *OutgoingStickerSet {
+	return &OutgoingStickerSet{
+		UserID:   userID,
+		Name:     name,
+		Title:    title,
+		Stickers: stickers,
+	}
+}
+
+// SetStickerType sets the type of the stickers in the set, defaults to StickerRegular (optional)
+func (os *OutgoingStickerSet) SetStickerType(to StickerKind) *OutgoingStickerSet {
+	os.StickerType = to
+	return os
+}
+
+// SetNeedsRepainting sets whether custom emoji stickers are repainted in the color of the context they are used in
+// (optional)
+func (os *OutgoingStickerSet) SetNeedsRepainting(to bool) *OutgoingStickerSet {
+	os.NeedsRepainting = to
+	return os
+}
+
+// GetFiles returns the paths of the files to upload, by the field names they are referenced as
+func (os *OutgoingStickerSet) GetFiles() map[string]string {
+	return stickerFiles(os.Stickers)
+}
+
+// GetQueryString returns a Querystring representing the sticker set
+func (os *OutgoingStickerSet) GetQueryString() Querystring {
+	toReturn := map[string]string{}
+	toReturn["user_id"] = fmt.Sprint(os.UserID)
+	toReturn["name"] = os.Name
+	toReturn["title"] = os.Title
+	toReturn["stickers"] = encodeStickers(os.Stickers)
+
+	if os.StickerType != "" {
+		toReturn["sticker_type"] = string(os.StickerType)
+	}
+
+	if os.NeedsRepainting {
+		toReturn["needs_repainting"] = fmt.Sprint(os.NeedsRepainting)
+	}
+
+	return Querystring(toReturn)
+}
+
+// OutgoingStickerAddition represents a sticker to be added to an existing sticker set
+type OutgoingStickerAddition struct {
+	UserID  int64         `json:"user_id"`
+	Name    string        `json:"name"`
+	Sticker *InputSticker `json:"sticker"`
+}
+
+// NewOutgoingStickerAddition creates a new addition of the sticker to the set with the given name, owned by the user
+// with ID userID
+func NewOutgoingStickerAddition(userID int64, name string, sticker *InputSticker) *OutgoingStickerAddition {
+	return &OutgoingStickerAddition{
+		UserID:  userID,
+		Name:    name,
+		Sticker: sticker,
+	}
+}
+
+// GetFiles returns the paths of the files to upload, by the field names they are referenced as
+func (oa *OutgoingStickerAddition) GetFiles() map[string]string {
+	return stickerFiles([]*InputSticker{oa.Sticker})
+}
+
+// GetQueryString returns a Querystring representing the addition
+func (oa *OutgoingStickerAddition) GetQueryString() Querystring {
+	toReturn := map[string]string{}
+	toReturn["user_id"] = fmt.Sprint(oa.UserID)
+	toReturn["name"] = oa.Name
+
+	sticker := *oa.Sticker
+	if sticker.IsUpload() {
+		sticker.Sticker = "attach://" + mediaFieldName(0)
+	}
+	b, err := json.Marshal(sticker)
+	if err != nil {
+		panic(err)
+	}
+	toReturn["sticker"] = string(b)
+
+	return Querystring(toReturn)
+}
+
+func stickerFiles(stickers []*InputSticker) map[string]string {
+	toReturn := map[string]string{}
+	for i, sticker := range stickers {
+		if sticker.IsUpload() {
+			toReturn[mediaFieldName(i)] = sticker.filePath
+		}
+	}
+	return toReturn
+}
+
+func encodeStickers(stickers []*InputSticker) string {
+	toSend := make([]InputSticker, 0, len(stickers))
+	for i, sticker := range stickers {
+		s := *sticker
+		if s.IsUpload() {
+			s.Sticker = "attach://" + mediaFieldName(i)
+		}
+		toSend = append(toSend, s)
+	}
+
+	b, err := json.Marshal(toSend)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
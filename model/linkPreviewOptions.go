@@ -0,0 +1,49 @@
+package model
+
+// LinkPreviewOptions describes how link previews are generated for a message.
+// The API only generates link previews for text messages, other outgoing types, for example captioned media, do not
+// accept them. They are set on an OutgoingMessage or an InputTextMessageContent.
+type LinkPreviewOptions struct {
+	IsDisabled       bool   `json:"is_disabled,omitempty"`
+	URL              string `json:"url,omitempty"`
+	PreferSmallMedia bool   `json:"prefer_small_media,omitempty"`
+	PreferLargeMedia bool   `json:"prefer_large_media,omitempty"`
+	ShowAboveText    bool   `json:"show_above_text,omitempty"`
+}
+
+// NewLinkPreviewOptions creates new link preview options, using the first URL in the text for the preview
+func NewLinkPreviewOptions() *LinkPreviewOptions {
+	return &LinkPreviewOptions{}
+}
+
+// SetDisabled sets whether the link preview is disabled (optional)
+func (lo *LinkPreviewOptions) SetDisabled(to bool) *LinkPreviewOptions {
+	lo.IsDisabled = to
+	return lo
+}
+
+// SetURL sets the URL to use for the link preview, instead of the first URL in the text (optional)
+func (lo *LinkPreviewOptions) SetURL(to string) *LinkPreviewOptions {
+	lo.URL = to
+	return lo
+}
+
+// SetPreferSmallMedia sets whether the media in the link preview should be shrunk (optional)
+// Only one of PreferSmallMedia and PreferLargeMedia can be set.
+func (lo *LinkPreviewOptions) SetPreferSmallMedia(to bool) *LinkPreviewOptions {
+	lo.PreferSmallMedia = to
+	return lo
+}
+
+// SetPreferLargeMedia sets whether the media in the link preview should be enlarged (optional)
+// Only one of PreferSmallMedia and PreferLargeMedia can be set.
+func (lo *LinkPreviewOptions) SetPreferLargeMedia(to bool) *LinkPreviewOptions {
+	lo.PreferLargeMedia = to
+	return lo
+}
+
+// SetShowAboveText sets whether the link preview is shown above the text (optional)
+func (lo *LinkPreviewOptions) SetShowAboveText(to bool) *LinkPreviewOptions {
+	lo.ShowAboveText = to
+	return lo
+}
@@ -2,6 +2,10 @@ package model
 
 // Location represents a point on the map
 type Location struct {
-	Longitude float32 `json:"longitude"`
-	Latitude  float32 `json:"latitude"`
+	Longitude            float64  `json:"longitude"`
+	Latitude             float64  `json:"latitude"`
+	HorizontalAccuracy   *float64 `json:"horizontal_accuracy"`    // the radius of uncertainty for the location, in meters
+	LivePeriod           *int     `json:"live_period"`            // time relative to the sending date during which the location can be updated, in seconds
+	Heading              *int     `json:"heading"`                // the direction in which the user is moving, in degrees
+	ProximityAlertRadius *int     `json:"proximity_alert_radius"` // the maximum distance for proximity alerts about approaching another chat member, in meters
 }
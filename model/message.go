@@ -1,11 +1,21 @@
 package model
 
+import (
+	"encoding/json"
+)
+
 // MessageResponse represents the response sent by the API on successful messages sent
 type MessageResponse struct {
 	BaseResponse
 	Message Message `json:"result"`
 }
 
+// MessagesResponse represents the response sent by the API on successful requests sending multiple messages
+type MessagesResponse struct {
+	BaseResponse
+	Messages []Message `json:"result"`
+}
+
 // Message represents a message
 type Message struct {
 	noReplyMessage
@@ -41,6 +51,20 @@ func (m *Message) Type() MessageType {
 		return VoiceType
 	} else if m.Contact != nil {
 		return ContactType
+	} else if m.Venue != nil {
+		return VenueType
+	} else if m.Dice != nil {
+		return DiceType
+	} else if m.Poll != nil {
+		return PollType
+	} else if m.Game != nil {
+		return GameType
+	} else if m.Invoice != nil {
+		return InvoiceType
+	} else if m.SuccessfulPayment != nil {
+		return SuccessfulPaymentType
+	} else if m.WebAppData != nil {
+		return WebAppDataType
 	} else if m.Location != nil {
 		return LocationType
 	} else if m.NewChatParticipant != nil {
@@ -53,34 +77,71 @@ func (m *Message) Type() MessageType {
 		return NewChatPhoto
 	} else if m.DeleteChatPhoto != nil {
 		return DeletedChatPohoto
+	} else if m.PinnedMessage != nil {
+		return PinnedMessage
 	} else if m.GroupChatCreated != nil {
 		return GroupChatCreated
+	} else if m.SupergroupChatCreated != nil {
+		return SupergroupChatCreated
+	} else if m.MigrateToChatID != nil {
+		return MigrateToChat
+	} else if m.MigrateFromChatID != nil {
+		return MigrateFromChat
+	} else if m.ForumTopicCreated != nil {
+		return ForumTopicCreated
+	} else if m.ForumTopicEdited != nil {
+		return ForumTopicEdited
+	} else if m.ForumTopicClosed != nil {
+		return ForumTopicClosed
+	} else if m.ForumTopicReopened != nil {
+		return ForumTopicReopened
 	}
 
 	return Unknown
 }
 
 type noReplyMessage struct {
-	Chat                Chat         `json:"chat"`                  // information about the chat
-	ID                  int          `json:"message_id"`            // message id
-	From                User         `json:"from"`                  // sender
-	Date                int          `json:"date"`                  // timestamp
-	ForwardFrom         *User        `json:"forward_from"`          // forwarded from who
-	ForwardDate         *int         `json:"forward_date"`          // forwarded from when
-	Text                *string      `json:"text"`                  // the actual text content
-	Caption             *string      `json:"caption"`               // caption for photo or video messages
-	Audio               *Audio       `json:"audio"`                 // information about audio contents
-	Document            *Document    `json:"document"`              // information about file contents
-	Photo               *[]PhotoSize `json:"photo"`                 // information about photo contents
-	Sticker             *Sticker     `json:"sticker"`               // information about sticker contents
-	Video               *Video       `json:"video"`                 // information about video contents
-	Voice               *Voice       `json:"voice"`                 // information about voice message contents
-	Contact             *Contact     `json:"contact"`               // information about contact contents
-	Location            *Location    `json:"location"`              // information about location contents
-	NewChatParticipant  *User        `json:"new_chat_participant"`  // information about a new chat participant
-	LeftChatParticipant *User        `json:"left_chat_participant"` // information about a chat participant who left
-	NewChatTitle        *string      `json:"new_chat_title"`        // information about changes in the group name
-	NewChatPhoto        *[]PhotoSize `json:"new_chat_photo"`        // information about a new chat photo
-	DeleteChatPhoto     *bool        `json:"delete_chat_photo"`     // information about a deleted chat photo
-	GroupChatCreated    *bool        `json:"group_chat_created"`    // information about a created group chat
+	Raw                   json.RawMessage     `json:"-"`                       // the raw JSON the message was unmarshaled from
+	Chat                  Chat                `json:"chat"`                    // information about the chat
+	ID                    int                 `json:"message_id"`              // message id
+	MessageThreadID       *int                `json:"message_thread_id"`       // the forum topic the message belongs to
+	IsTopicMessage        bool                `json:"is_topic_message"`        // whether the message was sent to a forum topic
+	From                  User                `json:"from"`                    // sender
+	Date                  int                 `json:"date"`                    // timestamp
+	ForwardFrom           *User               `json:"forward_from"`            // forwarded from who
+	ForwardDate           *int                `json:"forward_date"`            // forwarded from when
+	Text                  *string             `json:"text"`                    // the actual text content
+	Caption               *string             `json:"caption"`                 // caption for photo or video messages
+	MediaGroupID          *string             `json:"media_group_id"`          // the media group (album) this message belongs to
+	TextEntities          *[]MessageEntity    `json:"entities"`                // special entities like usernames, URLs or bot commands in the text
+	CaptionEntities       *[]MessageEntity    `json:"caption_entities"`        // special entities in the caption
+	Audio                 *Audio              `json:"audio"`                   // information about audio contents
+	Document              *Document           `json:"document"`                // information about file contents
+	Photo                 *[]PhotoSize        `json:"photo"`                   // information about photo contents
+	Sticker               *Sticker            `json:"sticker"`                 // information about sticker contents
+	Video                 *Video              `json:"video"`                   // information about video contents
+	Voice                 *Voice              `json:"voice"`                   // information about voice message contents
+	Contact               *Contact            `json:"contact"`                 // information about contact contents
+	Location              *Location           `json:"location"`                // information about location contents
+	Venue                 *Venue              `json:"venue"`                   // information about venue contents
+	Dice                  *Dice               `json:"dice"`                    // information about dice contents
+	Poll                  *Poll               `json:"poll"`                    // information about poll contents
+	Game                  *Game               `json:"game"`                    // information about game contents
+	Invoice               *Invoice            `json:"invoice"`                 // information about invoice contents
+	SuccessfulPayment     *SuccessfulPayment  `json:"successful_payment"`      // information about a successful payment
+	WebAppData            *WebAppData         `json:"web_app_data"`            // data sent by a Web App
+	NewChatParticipant    *User               `json:"new_chat_participant"`    // information about a new chat participant
+	LeftChatParticipant   *User               `json:"left_chat_participant"`   // information about a chat participant who left
+	NewChatTitle          *string             `json:"new_chat_title"`          // information about changes in the group name
+	NewChatPhoto          *[]PhotoSize        `json:"new_chat_photo"`          // information about a new chat photo
+	DeleteChatPhoto       *bool               `json:"delete_chat_photo"`       // information about a deleted chat photo
+	PinnedMessage         *noReplyMessage     `json:"pinned_message"`          // the message that was pinned
+	GroupChatCreated      *bool               `json:"group_chat_created"`      // information about a created group chat
+	SupergroupChatCreated *bool               `json:"supergroup_chat_created"` // information about a created supergroup
+	MigrateToChatID       *int64              `json:"migrate_to_chat_id"`      // the supergroup this group was migrated to
+	MigrateFromChatID     *int64              `json:"migrate_from_chat_id"`    // the group this supergroup was migrated from
+	ForumTopicCreated     *ForumTopicCreation `json:"forum_topic_created"`     // information about a created forum topic
+	ForumTopicEdited      *ForumTopicEdit     `json:"forum_topic_edited"`      // information about an edited forum topic
+	ForumTopicClosed      *struct{}           `json:"forum_topic_closed"`      // information about a closed forum topic
+	ForumTopicReopened    *struct{}           `json:"forum_topic_reopened"`    // information about a reopened forum topic
 }
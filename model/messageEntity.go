@@ -0,0 +1,143 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// EntityType is the type of a MessageEntity
+type EntityType string
+
+// Represents all the possible EntityTypes, see https://core.telegram.org/bots/api#messageentity
+const (
+	EntityMention       EntityType = "mention"       // @username
+	EntityHashtag       EntityType = "hashtag"       // #hashtag
+	EntityCashtag       EntityType = "cashtag"       // $USD
+	EntityBotCommand    EntityType = "bot_command"   // /start@jobs_bot
+	EntityURL           EntityType = "url"           // https://telegram.org
+	EntityEmail         EntityType = "email"         // do-not-reply@telegram.org
+	EntityPhoneNumber   EntityType = "phone_number"  // +1-212-555-0123
+	EntityBold          EntityType = "bold"          // bold text
+	EntityItalic        EntityType = "italic"        // italic text
+	EntityUnderline     EntityType = "underline"     // underlined text
+	EntityStrikethrough EntityType = "strikethrough" // strikethrough text
+	EntitySpoiler       EntityType = "spoiler"       // spoiler message
+	EntityCode          EntityType = "code"          // monowidth string
+	EntityPre           EntityType = "pre"           // monowidth block
+	EntityTextLink      EntityType = "text_link"     // for clickable text URLs
+	EntityTextMention   EntityType = "text_mention"  // for users without usernames
+)
+
+// MessageEntity represents one special entity in a text message, for example a hashtag, username or URL.
+// Note that Offset and Length are measured in UTF-16 code units, use EntityText to extract the text.
+type MessageEntity struct {
+	Type     EntityType `json:"type"`               // type of the entity
+	Offset   int        `json:"offset"`             // offset in UTF-16 code units to the start of the entity
+	Length   int        `json:"length"`             // length of the entity in UTF-16 code units
+	URL      *string    `json:"url,omitempty"`      // for text links only, url that will be opened after the user taps on the text
+	User     *User      `json:"user,omitempty"`     // for text mentions only, the mentioned user
+	Language *string    `json:"language,omitempty"` // for pre only, the programming language of the entity text
+}
+
+// Command represents a bot command contained in a message
+type Command struct {
+	Name string // the command without the leading slash
+	Bot  string // the username of the bot the command was addressed to, if any
+	Args string // the text following the command, up to the next command
+}
+
+// Entities returns the entities of the text of the message, or the entities of the caption for media messages
+func (m *Message) Entities() []MessageEntity {
+	if m.Text != nil {
+		if m.TextEntities == nil {
+			return nil
+		}
+		return *m.TextEntities
+	}
+	if m.CaptionEntities == nil {
+		return nil
+	}
+	return *m.CaptionEntities
+}
+
+// EntityText returns the part of the text or caption the entity refers to.
+// The entity should be one of the entities returned by Entities.
+func (m *Message) EntityText(e MessageEntity) string {
+	text := m.entitySource()
+	start, end := utf16Range(text, e.Offset, e.Length)
+	return text[start:end]
+}
+
+// Commands returns all bot commands contained in the message, in order of appearance
+func (m *Message) Commands() []Command {
+	text := m.entitySource()
+	var toReturn []Command
+	var last *Command
+	lastEnd := 0
+
+	for _, e := range m.Entities() {
+		if e.Type != EntityBotCommand {
+			continue
+		}
+		start, end := utf16Range(text, e.Offset, e.Length)
+		if last != nil {
+			last.Args = strings.TrimSpace(text[lastEnd:start])
+		}
+
+		cmd := Command{Name: strings.TrimPrefix(text[start:end], "/")}
+		if i := strings.Index(cmd.Name, "@"); i >= 0 {
+			cmd.Bot = cmd.Name[i+1:]
+			cmd.Name = cmd.Name[:i]
+		}
+		toReturn = append(toReturn, cmd)
+		last = &toReturn[len(toReturn)-1]
+		lastEnd = end
+	}
+
+	if last != nil {
+		last.Args = strings.TrimSpace(text[lastEnd:])
+	}
+
+	return toReturn
+}
+
+func (m *Message) entitySource() string {
+	if m.Text != nil {
+		return *m.Text
+	}
+	if m.Caption != nil {
+		return *m.Caption
+	}
+	return ""
+}
+
+// utf16Range converts an offset and length measured in UTF-16 code units to byte indices into s.
+// Both indices are clamped to the length of s.
+func utf16Range(s string, offset, length int) (int, int) {
+	start, end := len(s), len(s)
+	units := 0
+
+	for i, r := range s {
+		if units >= offset && start == len(s) {
+			start = i
+		}
+		if units >= offset+length {
+			end = i
+			break
+		}
+		units += utf16Len(r)
+	}
+
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// utf16Len returns the number of UTF-16 code units needed to encode r
+func utf16Len(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
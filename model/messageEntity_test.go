@@ -0,0 +1,112 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEntityText(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		offset int
+		length int
+		want   string
+	}{
+		{"ascii", "hello #tag", 6, 4, "#tag"},
+		{"after thumbs up", "👍 hello #tag", 9, 4, "#tag"},
+		{"after two emoji", "😀😀 @user", 5, 5, "@user"},
+		{"starts after surrogate pair", "😀bold", 2, 4, "bold"},
+		{"ends before surrogate pair", "bold😀", 0, 4, "bold"},
+		{"surrogate pair only", "a😀b", 1, 2, "😀"},
+		{"includes surrogate pair", "a😀b", 0, 4, "a😀b"},
+		{"multi-byte BMP", "äöü €uro", 4, 4, "€uro"},
+		{"ZWJ sequence", "👨‍👩‍👧 family", 0, 8, "👨‍👩‍👧"},
+		{"after ZWJ sequence", "👨‍👩‍👧 family", 9, 6, "family"},
+		{"offset out of range", "abcd", 100, 2, ""},
+		{"length out of range", "ab😀cd", 2, 100, "😀cd"},
+		{"empty entity", "abcd", 2, 0, ""},
+		{"offset inside surrogate pair", "😀x", 1, 2, "x"},
+	}
+
+	for _, tt := range tests {
+		text := tt.text
+		m := Message{noReplyMessage: noReplyMessage{Text: &text}}
+		got := m.EntityText(MessageEntity{Type: EntityBold, Offset: tt.offset, Length: tt.length})
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEntities(t *testing.T) {
+	text, caption := "text", "caption"
+	textEntities := []MessageEntity{{Type: EntityBold, Length: 4}}
+	captionEntities := []MessageEntity{{Type: EntityItalic, Length: 7}}
+
+	m := Message{noReplyMessage: noReplyMessage{Text: &text, TextEntities: &textEntities}}
+	if got := m.Entities(); !reflect.DeepEqual(got, textEntities) {
+		t.Errorf("text: got %v, want %v", got, textEntities)
+	}
+
+	m = Message{noReplyMessage: noReplyMessage{Caption: &caption, CaptionEntities: &captionEntities}}
+	if got := m.Entities(); !reflect.DeepEqual(got, captionEntities) {
+		t.Errorf("caption: got %v, want %v", got, captionEntities)
+	}
+	if got := m.EntityText(captionEntities[0]); got != caption {
+		t.Errorf("caption text: got %q, want %q", got, caption)
+	}
+
+	m = Message{noReplyMessage: noReplyMessage{Text: &text}}
+	if got := m.Entities(); got != nil {
+		t.Errorf("no entities: got %v", got)
+	}
+}
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		entities []MessageEntity
+		want     []Command
+	}{
+		{
+			name:     "plain",
+			text:     "/start",
+			entities: []MessageEntity{{Type: EntityBotCommand, Offset: 0, Length: 6}},
+			want:     []Command{{Name: "start"}},
+		},
+		{
+			name: "emoji arguments",
+			text: "/start@bot 👍 args /help",
+			entities: []MessageEntity{
+				{Type: EntityBotCommand, Offset: 0, Length: 10},
+				{Type: EntityBotCommand, Offset: 19, Length: 5},
+			},
+			want: []Command{{Name: "start", Bot: "bot", Args: "👍 args"}, {Name: "help"}},
+		},
+		{
+			name: "after ZWJ sequence",
+			text: "👨‍👩‍👧 /vote 😀",
+			entities: []MessageEntity{
+				{Type: EntityBold, Offset: 0, Length: 8},
+				{Type: EntityBotCommand, Offset: 9, Length: 5},
+			},
+			want: []Command{{Name: "vote", Args: "😀"}},
+		},
+		{
+			name:     "no commands",
+			text:     "👍 #tag",
+			entities: []MessageEntity{{Type: EntityHashtag, Offset: 3, Length: 4}},
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		text, entities := tt.text, tt.entities
+		m := Message{noReplyMessage: noReplyMessage{Text: &text, TextEntities: &entities}}
+		if got := m.Commands(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
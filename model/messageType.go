@@ -5,45 +5,75 @@ type MessageType int
 
 // Message types
 const (
-	TextType     MessageType = iota // text messages
-	AudioType                       // audio messages
-	DocumentType                    // files
-	PhotoType                       // photos
-	StickerType                     // stickers
-	VideoType                       // videos
-	VoiceType                       // voice messages
-	ContactType                     // contact information
-	LocationType                    // locations
+	TextType              MessageType = iota // text messages
+	AudioType                                // audio messages
+	DocumentType                             // files
+	PhotoType                                // photos
+	StickerType                              // stickers
+	VideoType                                // videos
+	VoiceType                                // voice messages
+	ContactType                              // contact information
+	LocationType                             // locations
+	VenueType                                // venues
+	DiceType                                 // dice
+	PollType                                 // polls
+	GameType                                 // games
+	InvoiceType                              // invoices
+	SuccessfulPaymentType                    // successful payments
+	WebAppDataType                           // data sent by Web Apps
 
 	chatActionsBegin
-	NewChatParticipant  // joined chat participants
-	LeftChatParticipant // left chat participants
-	NewChatTitle        // chat title changes
-	NewChatPhoto        // new chat photos
-	DeletedChatPohoto   // deleted chat photos
-	GroupChatCreated    // creation of a group chat
+	NewChatParticipant    // joined chat participants
+	LeftChatParticipant   // left chat participants
+	NewChatTitle          // chat title changes
+	NewChatPhoto          // new chat photos
+	DeletedChatPohoto     // deleted chat photos
+	PinnedMessage         // pinned messages
+	GroupChatCreated      // creation of a group chat
+	SupergroupChatCreated // creation of a supergroup
+	MigrateToChat         // migration of a group to a supergroup, sent to the old group
+	MigrateFromChat       // migration of a group to a supergroup, sent to the new supergroup
+	ForumTopicCreated     // creation of a forum topic
+	ForumTopicEdited      // changes of a forum topic
+	ForumTopicClosed      // closing of a forum topic
+	ForumTopicReopened    // reopening of a forum topic
 	chatActionsEnd
 
 	Unknown // unknown (probably new due to API changes)
 )
 
 var types = map[MessageType]string{
-	TextType:     "Text",
-	AudioType:    "Audio",
-	DocumentType: "Document",
-	PhotoType:    "Photo",
-	StickerType:  "Sticker",
-	VideoType:    "Video",
-	VoiceType:    "Voice",
-	ContactType:  "Contact",
-	LocationType: "Location",
-
-	NewChatParticipant:  "NewChatParticipant",
-	LeftChatParticipant: "LeftChatParticipant",
-	NewChatTitle:        "NewChatTitle",
-	NewChatPhoto:        "NewChatPhoto",
-	DeletedChatPohoto:   "DeletedChatPhoto",
-	GroupChatCreated:    "GroupChatCreated",
+	TextType:              "Text",
+	AudioType:             "Audio",
+	DocumentType:          "Document",
+	PhotoType:             "Photo",
+	StickerType:           "Sticker",
+	VideoType:             "Video",
+	VoiceType:             "Voice",
+	ContactType:           "Contact",
+	LocationType:          "Location",
+	VenueType:             "Venue",
+	DiceType:              "Dice",
+	PollType:              "Poll",
+	GameType:              "Game",
+	InvoiceType:           "Invoice",
+	SuccessfulPaymentType: "SuccessfulPayment",
+	WebAppDataType:        "WebAppData",
+
+	NewChatParticipant:    "NewChatParticipant",
+	LeftChatParticipant:   "LeftChatParticipant",
+	NewChatTitle:          "NewChatTitle",
+	NewChatPhoto:          "NewChatPhoto",
+	DeletedChatPohoto:     "DeletedChatPhoto",
+	PinnedMessage:         "PinnedMessage",
+	GroupChatCreated:      "GroupChatCreated",
+	SupergroupChatCreated: "SupergroupChatCreated",
+	MigrateToChat:         "MigrateToChat",
+	MigrateFromChat:       "MigrateFromChat",
+	ForumTopicCreated:     "ForumTopicCreated",
+	ForumTopicEdited:      "ForumTopicEdited",
+	ForumTopicClosed:      "ForumTopicClosed",
+	ForumTopicReopened:    "ForumTopicReopened",
 
 	Unknown: "UNKNOWN",
 }
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMessageType(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want MessageType
+	}{
+		{"text", `"text": "hello"`, TextType},
+		{"location", `"location": {"latitude": 52.5, "longitude": 13.25}`, LocationType},
+		{"venue", `"location": {"latitude": 52.5, "longitude": 13.25},
+			"venue": {"location": {"latitude": 52.5, "longitude": 13.25}, "title": "Venue", "address": "Street 1",
+				"foursquare_id": "4sq", "google_place_id": "ChIJ"}`, VenueType},
+		{"dice", `"dice": {"emoji": "🎯", "value": 6}`, DiceType},
+		{"pinned message", `"pinned_message": {"message_id": 2, "date": 1700000000, "chat": {"id": 1, "type": "private"},
+			"text": "pinned"}`, PinnedMessage},
+		{"forum topic created", `"message_thread_id": 7, "forum_topic_created": {"name": "Topic", "icon_color": 7322096}`, ForumTopicCreated},
+		{"forum topic edited", `"message_thread_id": 7, "forum_topic_edited": {"name": "Renamed"}`, ForumTopicEdited},
+		{"forum topic closed", `"message_thread_id": 7, "forum_topic_closed": {}`, ForumTopicClosed},
+		{"forum topic reopened", `"message_thread_id": 7, "forum_topic_reopened": {}`, ForumTopicReopened},
+		{"nothing", `"unknown_field": {}`, Unknown},
+	}
+
+	for _, tt := range tests {
+		var m Message
+		data := `{"message_id": 1, "date": 1700000000, "chat": {"id": 1, "type": "private"}, ` + tt.data + `}`
+		if err := json.Unmarshal([]byte(data), &m); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := m.Type(); got != tt.want {
+			t.Errorf("%s: got type %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMessageVenueAndDice(t *testing.T) {
+	var m Message
+	data := `{"message_id": 1, "date": 1700000000, "chat": {"id": 1, "type": "private"},
+		"venue": {"location": {"latitude": 52.5, "longitude": 13.25}, "title": "Venue", "address": "Street 1",
+			"foursquare_id": "4sq", "foursquare_type": "food", "google_place_id": "ChIJ", "google_place_type": "cafe"},
+		"dice": {"emoji": "🎰", "value": 64}}`
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	v := m.Venue
+	if v.Title != "Venue" || v.Location.Latitude != 52.5 || v.FoursquareID == nil || *v.FoursquareID != "4sq" ||
+		v.FoursquareType == nil || *v.FoursquareType != "food" || v.GooglePlaceID == nil || *v.GooglePlaceID != "ChIJ" ||
+		v.GooglePlaceType == nil || *v.GooglePlaceType != "cafe" {
+		t.Errorf("unexpected venue %+v", v)
+	}
+	if m.Dice.Emoji != DiceEmojiSlotMachine || m.Dice.Value != 64 {
+		t.Errorf("unexpected dice %+v", m.Dice)
+	}
+}
+
+func TestMessageThumbnails(t *testing.T) {
+	var m Message
+	data := `{"message_id": 1, "date": 1700000000, "chat": {"id": 1, "type": "private"},
+		"document": {"file_id": "document", "file_unique_id": "d", "file_name": "a.pdf",
+			"thumbnail": {"file_id": "document-thumb", "file_unique_id": "dt", "width": 90, "height": 90}},
+		"video": {"file_id": "video", "file_unique_id": "v", "width": 640, "height": 480, "duration": 5,
+			"thumbnail": {"file_id": "video-thumb", "file_unique_id": "vt", "width": 320, "height": 240}}}`
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Document.Thumbnail.ID != "document-thumb" || m.Document.Thumbnail.Width != 90 {
+		t.Errorf("unexpected document thumbnail %+v", m.Document.Thumbnail)
+	}
+	if m.Video.Thumbnail.ID != "video-thumb" || m.Video.Thumbnail.Width != 320 {
+		t.Errorf("unexpected video thumbnail %+v", m.Video.Thumbnail)
+	}
+}
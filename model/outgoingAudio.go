@@ -7,9 +7,11 @@ import (
 // OutgoingAudio represents an outgoing audio file
 type OutgoingAudio struct {
 	OutgoingBase
-	Duration  int    `json:"duration,omitempty"`
-	Title     string `json:"title,omitempty"`
-	Performer string `json:"performer,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	Duration        int             `json:"duration,omitempty"`
+	Title           string          `json:"title,omitempty"`
+	Performer       string          `json:"performer,omitempty"`
 }
 
 // NewOutgoingAudio creates a new outgoing audio file
@@ -21,6 +23,18 @@ func NewOutgoingAudio(recipient Recipient) *OutgoingAudio {
 	}
 }
 
+// SetCaption sets a caption for the audio file (optional)
+func (oa *OutgoingAudio) SetCaption(to string) *OutgoingAudio {
+	oa.Caption = to
+	return oa
+}
+
+// SetCaptionEntities sets the entities of the caption, for example those of a received message (optional)
+func (oa *OutgoingAudio) SetCaptionEntities(to []MessageEntity) *OutgoingAudio {
+	oa.CaptionEntities = to
+	return oa
+}
+
 // SetDuration sets a duration for the audio file (optional)
 func (oa *OutgoingAudio) SetDuration(to int) *OutgoingAudio {
 	oa.Duration = to
@@ -42,6 +56,7 @@ func (oa *OutgoingAudio) SetTitle(to string) *OutgoingAudio {
 // GetQueryString returns a Querystring representing the audio file
 func (oa *OutgoingAudio) GetQueryString() Querystring {
 	toReturn := map[string]string(oa.GetBaseQueryString())
+	addCaption(toReturn, oa.Caption, oa.CaptionEntities)
 
 	if oa.Duration != 0 {
 		toReturn["duration"] = fmt.Sprint(oa.Duration)
@@ -7,74 +7,130 @@ import (
 
 // OutgoingBase contains fields shared by most of the outgoing messages
 type OutgoingBase struct {
-	Recipient           Recipient   `json:"chat_id"`
-	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
-	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
-	replyToMessageIDSet bool
-	replyMarkupSet      bool
+	Recipient                Recipient        `json:"chat_id"`
+	MessageThreadID          int              `json:"message_thread_id,omitempty"`
+	DisableNotification      bool             `json:"disable_notification,omitempty"`
+	ProtectContent           bool             `json:"protect_content,omitempty"`
+	ReplyToMessageID         int              `json:"reply_to_message_id,omitempty"`
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`
+	messageThreadIDSet       bool
+	replyToMessageIDSet      bool
+	replyMarkupSet           bool
+}
+
+// SetMessageThreadID sets the ID of the forum topic to send the message to, for forum supergroups only (optional)
+func (op *OutgoingBase) SetMessageThreadID(to int) {
+	op.MessageThreadID = to
+	op.messageThreadIDSet = true
+}
+
+// SetDisableNotification sets whether the message should be sent silently (optional)
+func (op *OutgoingBase) SetDisableNotification(to bool) {
+	op.DisableNotification = to
+}
+
+// SetProtectContent sets whether the message should be protected from forwarding and saving (optional)
+func (op *OutgoingBase) SetProtectContent(to bool) {
+	op.ProtectContent = to
 }
 
 // SetReplyToMessageID sets the ID for the message to reply to (optional)
+// This replaces the ReplyParameters set by SetReplyParameters.
 func (op *OutgoingBase) SetReplyToMessageID(to int) {
+	op.ReplyParameters = nil
 	op.ReplyToMessageID = to
 	op.replyToMessageIDSet = true
 }
 
+// SetAllowSendingWithoutReply sets whether the message should be sent even if the message to reply to is not found
+// (optional)
+func (op *OutgoingBase) SetAllowSendingWithoutReply(to bool) {
+	op.AllowSendingWithoutReply = to
+}
+
+// SetReplyParameters sets the message to reply to, possibly in another chat or quoting a part of it (optional)
+// This replaces the ID set by SetReplyToMessageID.
+func (op *OutgoingBase) SetReplyParameters(to ReplyParameters) {
+	op.ReplyParameters = &to
+	op.ReplyToMessageID = 0
+	op.replyToMessageIDSet = false
+}
+
 // SetReplyKeyboardMarkup sets the ReplyKeyboardMarkup (optional)
-// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide or ForceReply can be set.
-// Attempting to set any of the other two or re-setting this will cause a panic.
+// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide, ForceReply or InlineKeyboardMarkup can be set.
+// Setting any of them replaces the one set before.
 func (op *OutgoingBase) SetReplyKeyboardMarkup(to ReplyKeyboardMarkup) {
-	if op.replyMarkupSet {
-		panic("Outgoing: Only one of ReplyKeyboardMarkup, ReplyKeyboardHide or ForceReply can be set")
-	}
-
 	op.ReplyMarkup = ReplyMarkup(to)
+	op.replyMarkupSet = true
 }
 
 // SetReplyKeyboardHide sets the ReplyKeyboardHide (optional)
-// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide or ForceReply can be set.
-// Attempting to set any of the other two or re-setting this will cause a panic.
+// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide, ForceReply or InlineKeyboardMarkup can be set.
+// Setting any of them replaces the one set before.
 func (op *OutgoingBase) SetReplyKeyboardHide(to ReplyKeyboardHide) {
 	if !to.HideKeyboard {
 		return
 	}
 
-	if op.replyMarkupSet {
-		panic("Outgoing: Only one of ReplyKeyboardMarkup, ReplyKeyboardHide or ForceReply can be set")
-	}
-
 	op.ReplyMarkup = ReplyMarkup(to)
+	op.replyMarkupSet = true
 }
 
 // SetForceReply sets ForceReply for this message (optional)
-// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide or ForceReply can be set.
-// Attempting to set any of the other two or re-setting this will cause a panic.
+// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide, ForceReply or InlineKeyboardMarkup can be set.
+// Setting any of them replaces the one set before.
 func (op *OutgoingBase) SetForceReply(to ForceReply) {
 	if !to.ForceReply {
 		return
 	}
 
-	if op.replyMarkupSet {
-		panic("Outgoing: Only one of ReplyKeyboardMarkup, ReplyKeyboardHide or ForceReply can be set")
-	}
+	op.ReplyMarkup = ReplyMarkup(to)
+	op.replyMarkupSet = true
+}
 
+// SetInlineKeyboardMarkup sets the InlineKeyboardMarkup (optional)
+// Note that only one of ReplyKeyboardMarkup, ReplyKeyboardHide, ForceReply or InlineKeyboardMarkup can be set.
+// Setting any of them replaces the one set before.
+func (op *OutgoingBase) SetInlineKeyboardMarkup(to InlineKeyboardMarkup) {
 	op.ReplyMarkup = ReplyMarkup(to)
+	op.replyMarkupSet = true
 }
 
 // GetBaseQueryString gets a Querystring representing this message
 func (op *OutgoingBase) GetBaseQueryString() Querystring {
 	toReturn := map[string]string{}
-	if op.Recipient.isChannel() {
-		//Channel
-		toReturn["chat_id"] = fmt.Sprint(*op.Recipient.ChannelID)
-	} else {
-		toReturn["chat_id"] = fmt.Sprint(*op.Recipient.ChatID)
+	toReturn["chat_id"] = op.Recipient.querystringValue()
+
+	if op.messageThreadIDSet {
+		toReturn["message_thread_id"] = fmt.Sprint(op.MessageThreadID)
+	}
+
+	if op.DisableNotification {
+		toReturn["disable_notification"] = fmt.Sprint(op.DisableNotification)
+	}
+
+	if op.ProtectContent {
+		toReturn["protect_content"] = fmt.Sprint(op.ProtectContent)
 	}
 
 	if op.replyToMessageIDSet {
 		toReturn["reply_to_message_id"] = fmt.Sprint(op.ReplyToMessageID)
 	}
 
+	if op.AllowSendingWithoutReply {
+		toReturn["allow_sending_without_reply"] = fmt.Sprint(op.AllowSendingWithoutReply)
+	}
+
+	if op.ReplyParameters != nil {
+		b, err := json.Marshal(op.ReplyParameters)
+		if err != nil {
+			panic(err)
+		}
+		toReturn["reply_parameters"] = string(b)
+	}
+
 	if op.replyMarkupSet {
 		b, err := json.Marshal(op.ReplyMarkup)
 		if err != nil {
@@ -85,3 +141,18 @@ func (op *OutgoingBase) GetBaseQueryString() Querystring {
 
 	return Querystring(toReturn)
 }
+
+// addCaption adds the caption and its entities, if set, to a querystring
+func addCaption(querystring map[string]string, caption string, entities []MessageEntity) {
+	if caption != "" {
+		querystring["caption"] = caption
+	}
+
+	if len(entities) > 0 {
+		b, err := json.Marshal(entities)
+		if err != nil {
+			panic(err)
+		}
+		querystring["caption_entities"] = string(b)
+	}
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// checkEncoding checks that the outgoing value contains the fields, both encoded as JSON and as a querystring.
+// JSON values are compared in their querystring representation.
+func checkEncoding(t *testing.T, name string, v interface{ GetQueryString() Querystring }, want map[string]string) {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	qs := v.GetQueryString()
+
+	for key, value := range want {
+		if value == "" {
+			if _, ok := fields[key]; ok {
+				t.Errorf("%s: JSON contains %s: %v", name, key, fields[key])
+			}
+			if _, ok := qs[key]; ok {
+				t.Errorf("%s: querystring contains %s: %s", name, key, qs[key])
+			}
+			continue
+		}
+
+		got := ""
+		switch f := fields[key].(type) {
+		case string:
+			got = f
+		case float64, bool:
+			got = fmt.Sprint(f)
+		case nil:
+		default:
+			b, _ := json.Marshal(f)
+			got = string(b)
+		}
+		if got != value {
+			t.Errorf("%s: got JSON %s %q, want %q", name, key, got, value)
+		}
+		if qs[key] != value {
+			t.Errorf("%s: got querystring %s %q, want %q", name, key, qs[key], value)
+		}
+	}
+}
+
+func TestMessageThreadID(t *testing.T) {
+	photo := NewOutgoingPhoto(NewChatRecipient(-100))
+	checkEncoding(t, "photo", photo, map[string]string{"message_thread_id": ""})
+	photo.SetMessageThreadID(7)
+	checkEncoding(t, "photo in topic", photo, map[string]string{"chat_id": "-100", "message_thread_id": "7"})
+
+	contact := NewOutgoingContact(NewChatRecipient(-100), "+49123", "Vlad")
+	contact.SetMessageThreadID(7)
+	checkEncoding(t, "contact in topic", contact, map[string]string{"chat_id": "-100", "message_thread_id": "7"})
+
+	b, err := json.Marshal(NewOutgoingForwards(NewChatRecipient(-100), Chat{ID: 1}, 1, 2).SetMessageThreadID(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["message_thread_id"] != 7.0 {
+		t.Errorf("forwards: got message_thread_id %v", fields["message_thread_id"])
+	}
+}
+
+func TestReplyExclusive(t *testing.T) {
+	tests := []struct {
+		name      string
+		set       func(op *OutgoingBase)
+		wantID    string
+		wantParam bool
+	}{
+		{"id", func(op *OutgoingBase) { op.SetReplyToMessageID(1) }, "1", false},
+		{"parameters", func(op *OutgoingBase) { op.SetReplyParameters(*NewReplyParameters(2)) }, "", true},
+		{"id after parameters", func(op *OutgoingBase) {
+			op.SetReplyParameters(*NewReplyParameters(2))
+			op.SetReplyToMessageID(1)
+		}, "1", false},
+		{"parameters after id", func(op *OutgoingBase) {
+			op.SetReplyToMessageID(1)
+			op.SetReplyParameters(*NewReplyParameters(2))
+		}, "", true},
+	}
+
+	for _, tt := range tests {
+		op := &OutgoingBase{Recipient: NewChatRecipient(1)}
+		tt.set(op)
+
+		qs := op.GetBaseQueryString()
+		if qs["reply_to_message_id"] != tt.wantID {
+			t.Errorf("%s: got reply_to_message_id %q, want %q", tt.name, qs["reply_to_message_id"], tt.wantID)
+		}
+		if _, ok := qs["reply_parameters"]; ok != tt.wantParam {
+			t.Errorf("%s: got reply_parameters %q", tt.name, qs["reply_parameters"])
+		}
+		if (op.ReplyParameters != nil) != tt.wantParam || (op.ReplyToMessageID != 0) == tt.wantParam {
+			t.Errorf("%s: got ReplyToMessageID %d and ReplyParameters %v", tt.name, op.ReplyToMessageID, op.ReplyParameters)
+		}
+	}
+}
+
+func TestReplyMarkupQueryString(t *testing.T) {
+	tests := []struct {
+		name string
+		set  func(op *OutgoingBase)
+		want string
+	}{
+		{"keyboard", func(op *OutgoingBase) {
+			op.SetReplyKeyboardMarkup(ReplyKeyboardMarkup{Keyboard: [][]string{{"a"}}})
+		}, `{"keyboard":[["a"]],"resize_keyboard":false,"one_time_keyboard":false,"selective":false}`},
+		{"hide", func(op *OutgoingBase) {
+			op.SetReplyKeyboardHide(ReplyKeyboardHide{HideKeyboard: true})
+		}, `{"hide_keyboard":true,"selective":false}`},
+		{"force reply", func(op *OutgoingBase) {
+			op.SetForceReply(ForceReply{ForceReply: true})
+		}, `{"force_reply":true,"selective":false}`},
+		{"disabled force reply", func(op *OutgoingBase) {
+			op.SetForceReply(ForceReply{})
+		}, ""},
+	}
+
+	for _, tt := range tests {
+		op := &OutgoingBase{Recipient: NewChatRecipient(1)}
+		tt.set(op)
+
+		if got := op.GetBaseQueryString()["reply_markup"]; got != tt.want {
+			t.Errorf("%s: got reply_markup %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReplyMarkupSetTwice(t *testing.T) {
+	op := &OutgoingBase{Recipient: NewChatRecipient(1)}
+	op.SetReplyKeyboardMarkup(ReplyKeyboardMarkup{Keyboard: [][]string{{"a"}}})
+	op.SetForceReply(ForceReply{ForceReply: true})
+	if got := op.GetBaseQueryString()["reply_markup"]; got != `{"force_reply":true,"selective":false}` {
+		t.Errorf("got reply_markup %s after setting force reply", got)
+	}
+
+	op.SetInlineKeyboardMarkup(InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{NewInlineKeyboardButtonCallback("b", "c")}}})
+	if got := op.GetBaseQueryString()["reply_markup"]; got != `{"inline_keyboard":[[{"text":"b","callback_data":"c"}]]}` {
+		t.Errorf("got reply_markup %s after setting an inline keyboard", got)
+	}
+
+	op.SetForceReply(ForceReply{})
+	if got := op.GetBaseQueryString()["reply_markup"]; got != `{"inline_keyboard":[[{"text":"b","callback_data":"c"}]]}` {
+		t.Errorf("disabled force reply replaced the inline keyboard, got %s", got)
+	}
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"encoding/json"
+)
+
+// InviteLinkResponse represents the response sent by the API on an ExportChatInviteLink request
+type InviteLinkResponse struct {
+	BaseResponse
+	Link string `json:"result"`
+}
+
+// ChatInviteLinkResponse represents the response sent by the API on requests creating or changing invite links
+type ChatInviteLinkResponse struct {
+	BaseResponse
+	ChatInviteLink ChatInviteLink `json:"result"`
+}
+
+// OutgoingChatInviteLink represents an invite link to be created or edited
+type OutgoingChatInviteLink struct {
+	Recipient          Recipient `json:"chat_id"`
+	InviteLink         string    `json:"invite_link,omitempty"`
+	Name               string    `json:"name,omitempty"`
+	ExpireDate         int       `json:"expire_date,omitempty"`
+	MemberLimit        int       `json:"member_limit,omitempty"`
+	CreatesJoinRequest bool      `json:"creates_join_request,omitempty"`
+}
+
+// NewOutgoingChatInviteLink creates a new invite link for the chat
+func NewOutgoingChatInviteLink(recipient Recipient) *OutgoingChatInviteLink {
+	return &OutgoingChatInviteLink{
+		Recipient: recipient,
+	}
+}
+
+// NewOutgoingChatInviteLinkEdit creates a new edit of an existing invite link of the chat.
+// Note that all properties of the link are replaced, unset properties are reset. In particular, an ExpireDate or
+// MemberLimit of 0 is sent and removes the expiry date or member limit of the link.
+func NewOutgoingChatInviteLinkEdit(recipient Recipient, inviteLink string) *OutgoingChatInviteLink {
+	return &OutgoingChatInviteLink{
+		Recipient:  recipient,
+		InviteLink: inviteLink,
+	}
+}
+
+// SetName sets the name of the invite link, 0-32 characters (optional)
+func (ol *OutgoingChatInviteLink) SetName(to string) *OutgoingChatInviteLink {
+	ol.Name = to
+	return ol
+}
+
+// SetExpireDate sets the timestamp when the link will expire, 0 for never (optional)
+func (ol *OutgoingChatInviteLink) SetExpireDate(to int) *OutgoingChatInviteLink {
+	ol.ExpireDate = to
+	return ol
+}
+
+// SetMemberLimit sets the maximum number of users that can be members of the chat simultaneously after joining via
+// the link, 1-99999, or 0 for no limit (optional)
+// Only one of MemberLimit and CreatesJoinRequest can be set.
+func (ol *OutgoingChatInviteLink) SetMemberLimit(to int) *OutgoingChatInviteLink {
+	ol.MemberLimit = to
+	return ol
+}
+
+// SetCreatesJoinRequest sets whether users joining via the link need to be approved, see ApproveChatJoinRequest
+// (optional)
+// Only one of MemberLimit and CreatesJoinRequest can be set.
+func (ol *OutgoingChatInviteLink) SetCreatesJoinRequest(to bool) *OutgoingChatInviteLink {
+	ol.CreatesJoinRequest = to
+	return ol
+}
+
+// MarshalJSON marshals the invite link to JSON.
+// For edits, ExpireDate and MemberLimit are always included, so that they can be reset to 0.
+func (ol OutgoingChatInviteLink) MarshalJSON() ([]byte, error) {
+	type plain OutgoingChatInviteLink
+	if ol.InviteLink == "" {
+		return json.Marshal(plain(ol))
+	}
+
+	return json.Marshal(struct {
+		plain
+		ExpireDate  int `json:"expire_date"`
+		MemberLimit int `json:"member_limit"`
+	}{
+		plain:       plain(ol),
+		ExpireDate:  ol.ExpireDate,
+		MemberLimit: ol.MemberLimit,
+	})
+}
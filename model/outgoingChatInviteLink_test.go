@@ -0,0 +1,34 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOutgoingChatInviteLinkJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		link *OutgoingChatInviteLink
+		want string
+	}{
+		{"create", NewOutgoingChatInviteLink(NewChatRecipient(-100)), `{"chat_id":-100}`},
+		{"create with limit", NewOutgoingChatInviteLink(NewChatRecipient(-100)).SetName("friends").SetExpireDate(1700000000).SetMemberLimit(10),
+			`{"chat_id":-100,"name":"friends","expire_date":1700000000,"member_limit":10}`},
+		{"edit", NewOutgoingChatInviteLinkEdit(NewChatRecipient(-100), "https://t.me/+abc"),
+			`{"chat_id":-100,"invite_link":"https://t.me/+abc","expire_date":0,"member_limit":0}`},
+		{"edit with join requests", NewOutgoingChatInviteLinkEdit(NewChatRecipient(-100), "https://t.me/+abc").SetCreatesJoinRequest(true),
+			`{"chat_id":-100,"invite_link":"https://t.me/+abc","creates_join_request":true,"expire_date":0,"member_limit":0}`},
+		{"edit with limit", NewOutgoingChatInviteLinkEdit(NewChatRecipient(-100), "https://t.me/+abc").SetExpireDate(1700000000).SetMemberLimit(10),
+			`{"chat_id":-100,"invite_link":"https://t.me/+abc","expire_date":1700000000,"member_limit":10}`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.link)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, b, tt.want)
+		}
+	}
+}
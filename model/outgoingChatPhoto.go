@@ -0,0 +1,20 @@
+package model
+
+// OutgoingChatPhoto represents a new chat photo to be uploaded
+type OutgoingChatPhoto struct {
+	Recipient Recipient `json:"chat_id"`
+}
+
+// NewOutgoingChatPhoto creates a new chat photo for the chat
+func NewOutgoingChatPhoto(recipient Recipient) *OutgoingChatPhoto {
+	return &OutgoingChatPhoto{
+		Recipient: recipient,
+	}
+}
+
+// GetQueryString gets a Querystring representing this chat photo
+func (op *OutgoingChatPhoto) GetQueryString() Querystring {
+	return Querystring(map[string]string{
+		"chat_id": op.Recipient.querystringValue(),
+	})
+}
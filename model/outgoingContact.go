@@ -0,0 +1,51 @@
+package model
+
+// OutgoingContact represents an outgoing phone contact
+type OutgoingContact struct {
+	OutgoingBase
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+	VCard       string `json:"vcard,omitempty"`
+}
+
+// NewOutgoingContact creates a new outgoing phone contact
+func NewOutgoingContact(recipient Recipient, phoneNumber, firstName string) *OutgoingContact {
+	return &OutgoingContact{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		PhoneNumber: phoneNumber,
+		FirstName:   firstName,
+	}
+}
+
+// SetLastName sets the last name of the contact (optional)
+func (oc *OutgoingContact) SetLastName(to string) *OutgoingContact {
+	oc.LastName = to
+	return oc
+}
+
+// SetVCard sets additional data about the contact in the form of a vCard (optional)
+func (oc *OutgoingContact) SetVCard(to string) *OutgoingContact {
+	oc.VCard = to
+	return oc
+}
+
+// GetQueryString returns a Querystring representing the contact
+func (oc *OutgoingContact) GetQueryString() Querystring {
+	toReturn := map[string]string(oc.GetBaseQueryString())
+
+	toReturn["phone_number"] = oc.PhoneNumber
+	toReturn["first_name"] = oc.FirstName
+
+	if oc.LastName != "" {
+		toReturn["last_name"] = oc.LastName
+	}
+
+	if oc.VCard != "" {
+		toReturn["vcard"] = oc.VCard
+	}
+
+	return Querystring(toReturn)
+}
@@ -0,0 +1,145 @@
+package model
+
+// MessageIDResponse represents the response sent by the API on a CopyMessage request
+type MessageIDResponse struct {
+	BaseResponse
+	MessageID MessageID `json:"result"`
+}
+
+// MessageIDsResponse represents the response sent by the API on requests forwarding or copying multiple messages
+type MessageIDsResponse struct {
+	BaseResponse
+	MessageIDs []MessageID `json:"result"`
+}
+
+// MessageID identifies a message
+type MessageID struct {
+	ID int `json:"message_id"`
+}
+
+// OutgoingCopy represents an outgoing copy of a message.
+// Unlike forwarded messages, copies do not link to the original message.
+type OutgoingCopy struct {
+	OutgoingBase
+	FromChatID      Recipient       `json:"from_chat_id"`
+	MessageID       int             `json:"message_id"`
+	Caption         *string         `json:"caption,omitempty"`
+	ParseMode       ParseMode       `json:"parse_mode,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+}
+
+// NewOutgoingCopy creates a new outgoing copy of a message
+func NewOutgoingCopy(recipient Recipient, origin Chat, messageID int) *OutgoingCopy {
+	return &OutgoingCopy{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		FromChatID: NewRecipientFromChat(origin),
+		MessageID:  messageID,
+	}
+}
+
+// SetCaption replaces the caption of the copied message, the empty string removes it (optional)
+// If not set, the original caption is kept.
+func (oc *OutgoingCopy) SetCaption(to string) *OutgoingCopy {
+	oc.Caption = &to
+	return oc
+}
+
+// SetParseMode sets the ParseMode for the replaced caption (optional)
+func (oc *OutgoingCopy) SetParseMode(to ParseMode) *OutgoingCopy {
+	oc.ParseMode = to
+	return oc
+}
+
+// SetFormattedCaption replaces the caption of the copied message with the formatted text.
+// The formatting is sent as entities, so the ParseMode is reset.
+func (oc *OutgoingCopy) SetFormattedCaption(to *FormattedText) *OutgoingCopy {
+	caption, entities := to.Entities()
+	oc.Caption, oc.CaptionEntities = &caption, entities
+	oc.ParseMode = ModeDefault
+	return oc
+}
+
+// OutgoingForwards represents multiple messages to be forwarded at once
+type OutgoingForwards struct {
+	Recipient           Recipient `json:"chat_id"`
+	MessageThreadID     int       `json:"message_thread_id,omitempty"`
+	FromChatID          Recipient `json:"from_chat_id"`
+	MessageIDs          []int     `json:"message_ids"`
+	DisableNotification bool      `json:"disable_notification,omitempty"`
+	ProtectContent      bool      `json:"protect_content,omitempty"`
+}
+
+// NewOutgoingForwards creates new outgoing, forwarded messages.
+// Between 1 and 100 message IDs can be given, albums are kept together.
+func NewOutgoingForwards(recipient Recipient, origin Chat, messageIDs ...int) *OutgoingForwards {
+	return &OutgoingForwards{
+		Recipient:  recipient,
+		FromChatID: NewRecipientFromChat(origin),
+		MessageIDs: messageIDs,
+	}
+}
+
+// SetDisableNotification sets whether the messages should be sent silently (optional)
+func (of *OutgoingForwards) SetDisableNotification(to bool) *OutgoingForwards {
+	of.DisableNotification = to
+	return of
+}
+
+// SetProtectContent sets whether the messages should be protected from forwarding and saving (optional)
+func (of *OutgoingForwards) SetProtectContent(to bool) *OutgoingForwards {
+	of.ProtectContent = to
+	return of
+}
+
+// SetMessageThreadID sets the ID of the forum topic to send the messages to, for forum supergroups only (optional)
+func (of *OutgoingForwards) SetMessageThreadID(to int) *OutgoingForwards {
+	of.MessageThreadID = to
+	return of
+}
+
+// OutgoingCopies represents multiple messages to be copied at once
+type OutgoingCopies struct {
+	Recipient           Recipient `json:"chat_id"`
+	MessageThreadID     int       `json:"message_thread_id,omitempty"`
+	FromChatID          Recipient `json:"from_chat_id"`
+	MessageIDs          []int     `json:"message_ids"`
+	DisableNotification bool      `json:"disable_notification,omitempty"`
+	ProtectContent      bool      `json:"protect_content,omitempty"`
+	RemoveCaption       bool      `json:"remove_caption,omitempty"`
+}
+
+// NewOutgoingCopies creates new outgoing copies of messages.
+// Between 1 and 100 message IDs can be given, albums are kept together.
+func NewOutgoingCopies(recipient Recipient, origin Chat, messageIDs ...int) *OutgoingCopies {
+	return &OutgoingCopies{
+		Recipient:  recipient,
+		FromChatID: NewRecipientFromChat(origin),
+		MessageIDs: messageIDs,
+	}
+}
+
+// SetDisableNotification sets whether the messages should be sent silently (optional)
+func (oc *OutgoingCopies) SetDisableNotification(to bool) *OutgoingCopies {
+	oc.DisableNotification = to
+	return oc
+}
+
+// SetProtectContent sets whether the messages should be protected from forwarding and saving (optional)
+func (oc *OutgoingCopies) SetProtectContent(to bool) *OutgoingCopies {
+	oc.ProtectContent = to
+	return oc
+}
+
+// SetMessageThreadID sets the ID of the forum topic to send the messages to, for forum supergroups only (optional)
+func (oc *OutgoingCopies) SetMessageThreadID(to int) *OutgoingCopies {
+	oc.MessageThreadID = to
+	return oc
+}
+
+// SetRemoveCaption sets whether the captions of the messages should be removed (optional)
+func (oc *OutgoingCopies) SetRemoveCaption(to bool) *OutgoingCopies {
+	oc.RemoveCaption = to
+	return oc
+}
@@ -0,0 +1,33 @@
+package model
+
+// OutgoingDice represents an outgoing animated emoji that displays a random value
+type OutgoingDice struct {
+	OutgoingBase
+	Emoji DiceEmoji `json:"emoji,omitempty"`
+}
+
+// NewOutgoingDice creates a new outgoing dice
+func NewOutgoingDice(recipient Recipient) *OutgoingDice {
+	return &OutgoingDice{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+	}
+}
+
+// SetEmoji sets the emoji the dice throw animation is based on, defaults to DiceEmojiDice (optional)
+func (od *OutgoingDice) SetEmoji(to DiceEmoji) *OutgoingDice {
+	od.Emoji = to
+	return od
+}
+
+// GetQueryString returns a Querystring representing the dice
+func (od *OutgoingDice) GetQueryString() Querystring {
+	toReturn := map[string]string(od.GetBaseQueryString())
+
+	if od.Emoji != "" {
+		toReturn["emoji"] = string(od.Emoji)
+	}
+
+	return Querystring(toReturn)
+}
@@ -3,6 +3,8 @@ package model
 // OutgoingDocument represents an outgoing file
 type OutgoingDocument struct {
 	OutgoingBase
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // NewOutgoingDocument creates a new outgoing file
@@ -14,7 +16,21 @@ func NewOutgoingDocument(recipient Recipient) *OutgoingDocument {
 	}
 }
 
+// SetCaption sets a caption for the file (optional)
+func (od *OutgoingDocument) SetCaption(to string) *OutgoingDocument {
+	od.Caption = to
+	return od
+}
+
+// SetCaptionEntities sets the entities of the caption, for example those of a received message (optional)
+func (od *OutgoingDocument) SetCaptionEntities(to []MessageEntity) *OutgoingDocument {
+	od.CaptionEntities = to
+	return od
+}
+
 // GetQueryString returns a Querystring representing the outgoing file
 func (od *OutgoingDocument) GetQueryString() Querystring {
-	return od.GetBaseQueryString()
+	toReturn := map[string]string(od.GetBaseQueryString())
+	addCaption(toReturn, od.Caption, od.CaptionEntities)
+	return Querystring(toReturn)
 }
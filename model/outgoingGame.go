@@ -0,0 +1,50 @@
+package model
+
+// OutgoingGame represents an outgoing game
+type OutgoingGame struct {
+	OutgoingBase
+	GameShortName string `json:"game_short_name"`
+}
+
+// NewOutgoingGame creates a new outgoing game.
+// The short name of the game is set up via BotFather.
+func NewOutgoingGame(recipient Recipient, gameShortName string) *OutgoingGame {
+	return &OutgoingGame{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		GameShortName: gameShortName,
+	}
+}
+
+// OutgoingGameScore represents an outgoing score of a user in a game
+type OutgoingGameScore struct {
+	Recipient          Recipient `json:"chat_id"`
+	MessageID          int       `json:"message_id"`
+	UserID             int64     `json:"user_id"`
+	Score              int       `json:"score"`
+	Force              bool      `json:"force,omitempty"`
+	DisableEditMessage bool      `json:"disable_edit_message,omitempty"`
+}
+
+// NewOutgoingGameScore creates a new score of a user in the game contained in the message with ID messageID
+func NewOutgoingGameScore(recipient Recipient, messageID int, userID int64, score int) *OutgoingGameScore {
+	return &OutgoingGameScore{
+		Recipient: recipient,
+		MessageID: messageID,
+		UserID:    userID,
+		Score:     score,
+	}
+}
+
+// SetForce sets whether the score may decrease, useful when fixing mistakes or banning cheaters (optional)
+func (os *OutgoingGameScore) SetForce(to bool) *OutgoingGameScore {
+	os.Force = to
+	return os
+}
+
+// SetDisableEditMessage sets whether the game message should not be edited to include the current scoreboard (optional)
+func (os *OutgoingGameScore) SetDisableEditMessage(to bool) *OutgoingGameScore {
+	os.DisableEditMessage = to
+	return os
+}
@@ -0,0 +1,122 @@
+package model
+
+// InvoiceDetails contains the details of an invoice, shared by invoice messages and invoice links
+type InvoiceDetails struct {
+	Title                     string         `json:"title"`
+	Description               string         `json:"description"`
+	Payload                   string         `json:"payload"`
+	ProviderToken             string         `json:"provider_token"`
+	Currency                  string         `json:"currency"`
+	Prices                    []LabeledPrice `json:"prices"`
+	MaxTipAmount              int            `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts       []int          `json:"suggested_tip_amounts,omitempty"`
+	StartParameter            string         `json:"start_parameter,omitempty"`
+	ProviderData              string         `json:"provider_data,omitempty"`
+	PhotoURL                  string         `json:"photo_url,omitempty"`
+	PhotoSize                 int            `json:"photo_size,omitempty"`
+	PhotoWidth                int            `json:"photo_width,omitempty"`
+	PhotoHeight               int            `json:"photo_height,omitempty"`
+	NeedName                  bool           `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool           `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool           `json:"need_email,omitempty"`
+	NeedShippingAddress       bool           `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool           `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool           `json:"send_email_to_provider,omitempty"`
+	IsFlexible                bool           `json:"is_flexible,omitempty"`
+}
+
+// NewInvoiceDetails creates new invoice details.
+// The payload is not shown to the user, use it for internal processes.
+// The provider token is obtained via BotFather.
+func NewInvoiceDetails(title, description, payload, providerToken, currency string, prices ...LabeledPrice) *InvoiceDetails {
+	return &InvoiceDetails{
+		Title:         title,
+		Description:   description,
+		Payload:       payload,
+		ProviderToken: providerToken,
+		Currency:      currency,
+		Prices:        prices,
+	}
+}
+
+// SetTips sets the maximum accepted tip and up to four suggested tips, in the smallest units of the currency (optional)
+func (iv *InvoiceDetails) SetTips(max int, suggested ...int) *InvoiceDetails {
+	iv.MaxTipAmount = max
+	iv.SuggestedTipAmounts = suggested
+	return iv
+}
+
+// SetStartParameter sets the deep-linking parameter used for forwarded copies of the invoice (optional)
+func (iv *InvoiceDetails) SetStartParameter(to string) *InvoiceDetails {
+	iv.StartParameter = to
+	return iv
+}
+
+// SetProviderData sets JSON-serialized data about the invoice to be shared with the payment provider (optional)
+func (iv *InvoiceDetails) SetProviderData(to string) *InvoiceDetails {
+	iv.ProviderData = to
+	return iv
+}
+
+// SetPhoto sets a product photo by URL, with its size in bytes and dimensions (optional)
+func (iv *InvoiceDetails) SetPhoto(url string, size, width, height int) *InvoiceDetails {
+	iv.PhotoURL = url
+	iv.PhotoSize = size
+	iv.PhotoWidth = width
+	iv.PhotoHeight = height
+	return iv
+}
+
+// SetNeedName sets whether the user's full name is required to complete the order (optional)
+func (iv *InvoiceDetails) SetNeedName(to bool) *InvoiceDetails {
+	iv.NeedName = to
+	return iv
+}
+
+// SetNeedPhoneNumber sets whether the user's phone number is required to complete the order (optional)
+func (iv *InvoiceDetails) SetNeedPhoneNumber(to bool) *InvoiceDetails {
+	iv.NeedPhoneNumber = to
+	return iv
+}
+
+// SetNeedEmail sets whether the user's email address is required to complete the order (optional)
+func (iv *InvoiceDetails) SetNeedEmail(to bool) *InvoiceDetails {
+	iv.NeedEmail = to
+	return iv
+}
+
+// SetNeedShippingAddress sets whether the user's shipping address is required to complete the order (optional)
+func (iv *InvoiceDetails) SetNeedShippingAddress(to bool) *InvoiceDetails {
+	iv.NeedShippingAddress = to
+	return iv
+}
+
+// SetSendToProvider sets whether the user's phone number and email address are sent to the provider (optional)
+func (iv *InvoiceDetails) SetSendToProvider(phoneNumber, email bool) *InvoiceDetails {
+	iv.SendPhoneNumberToProvider = phoneNumber
+	iv.SendEmailToProvider = email
+	return iv
+}
+
+// SetFlexible sets whether the final price depends on the shipping method (optional)
+// Flexible invoices cause ShippingQuery updates, see AnswerShippingQuery.
+func (iv *InvoiceDetails) SetFlexible(to bool) *InvoiceDetails {
+	iv.IsFlexible = to
+	return iv
+}
+
+// OutgoingInvoice represents an outgoing invoice
+type OutgoingInvoice struct {
+	OutgoingBase
+	InvoiceDetails
+}
+
+// NewOutgoingInvoice creates a new outgoing invoice with the given details
+func NewOutgoingInvoice(recipient Recipient, details *InvoiceDetails) *OutgoingInvoice {
+	return &OutgoingInvoice{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		InvoiceDetails: *details,
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+// OutgoingLiveLocationEdit represents an update of a live location message
+type OutgoingLiveLocationEdit struct {
+	Recipient            Recipient `json:"chat_id"`
+	MessageID            int       `json:"message_id"`
+	Latitude             float64   `json:"latitude"`
+	Longitude            float64   `json:"longitude"`
+	HorizontalAccuracy   float64   `json:"horizontal_accuracy,omitempty"`
+	Heading              int       `json:"heading,omitempty"`
+	ProximityAlertRadius int       `json:"proximity_alert_radius,omitempty"`
+}
+
+// NewOutgoingLiveLocationEdit creates a new update of the live location message with ID messageID
+func NewOutgoingLiveLocationEdit(recipient Recipient, messageID int, latitude, longitude float64) *OutgoingLiveLocationEdit {
+	return &OutgoingLiveLocationEdit{
+		Recipient: recipient,
+		MessageID: messageID,
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+// NewOutgoingLiveLocationEditFromLocation creates a new update of the live location message with ID messageID,
+// using the coordinates, accuracy, heading and proximity alert radius of the given location
+func NewOutgoingLiveLocationEditFromLocation(recipient Recipient, messageID int, location Location) *OutgoingLiveLocationEdit {
+	toReturn := NewOutgoingLiveLocationEdit(recipient, messageID, location.Latitude, location.Longitude)
+	if location.HorizontalAccuracy != nil {
+		toReturn.HorizontalAccuracy = *location.HorizontalAccuracy
+	}
+	if location.Heading != nil {
+		toReturn.Heading = *location.Heading
+	}
+	if location.ProximityAlertRadius != nil {
+		toReturn.ProximityAlertRadius = *location.ProximityAlertRadius
+	}
+	return toReturn
+}
+
+// SetHorizontalAccuracy sets the radius of uncertainty for the location in meters, 0-1500 (optional)
+func (oe *OutgoingLiveLocationEdit) SetHorizontalAccuracy(to float64) *OutgoingLiveLocationEdit {
+	oe.HorizontalAccuracy = to
+	return oe
+}
+
+// SetHeading sets the direction in which the user is moving in degrees, 1-360 (optional)
+func (oe *OutgoingLiveLocationEdit) SetHeading(to int) *OutgoingLiveLocationEdit {
+	oe.Heading = to
+	return oe
+}
+
+// SetProximityAlertRadius sets the maximum distance in meters for proximity alerts about approaching another chat
+// member, 1-100000 (optional)
+func (oe *OutgoingLiveLocationEdit) SetProximityAlertRadius(to int) *OutgoingLiveLocationEdit {
+	oe.ProximityAlertRadius = to
+	return oe
+}
@@ -7,12 +7,16 @@ import (
 // OutgoingLocation represents an outgoing location on a map
 type OutgoingLocation struct {
 	OutgoingBase
-	Latitude  float32 `json:"latitude"`
-	Longitude float32 `json:"longitude"`
+	Latitude             float64 `json:"latitude"`
+	Longitude            float64 `json:"longitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int     `json:"live_period,omitempty"`
+	Heading              int     `json:"heading,omitempty"`
+	ProximityAlertRadius int     `json:"proximity_alert_radius,omitempty"`
 }
 
 // NewOutgoingLocation creates a new outgoing location
-func NewOutgoingLocation(recipient Recipient, latitude, longitude float32) *OutgoingLocation {
+func NewOutgoingLocation(recipient Recipient, latitude, longitude float64) *OutgoingLocation {
 	return &OutgoingLocation{
 		OutgoingBase: OutgoingBase{
 			Recipient: recipient,
@@ -22,6 +26,32 @@ func NewOutgoingLocation(recipient Recipient, latitude, longitude float32) *Outg
 	}
 }
 
+// SetHorizontalAccuracy sets the radius of uncertainty for the location in meters, 0-1500 (optional)
+func (ol *OutgoingLocation) SetHorizontalAccuracy(to float64) *OutgoingLocation {
+	ol.HorizontalAccuracy = to
+	return ol
+}
+
+// SetLivePeriod sets the period in seconds during which the location will be updated, 60-86400 (optional)
+// Setting a live period makes the location a live location, see EditMessageLiveLocation.
+func (ol *OutgoingLocation) SetLivePeriod(to int) *OutgoingLocation {
+	ol.LivePeriod = to
+	return ol
+}
+
+// SetHeading sets the direction in which the user is moving in degrees, 1-360, for live locations (optional)
+func (ol *OutgoingLocation) SetHeading(to int) *OutgoingLocation {
+	ol.Heading = to
+	return ol
+}
+
+// SetProximityAlertRadius sets the maximum distance in meters for proximity alerts about approaching another chat
+// member, 1-100000, for live locations (optional)
+func (ol *OutgoingLocation) SetProximityAlertRadius(to int) *OutgoingLocation {
+	ol.ProximityAlertRadius = to
+	return ol
+}
+
 // GetQueryString returns a Querystring representing the location
 func (ol *OutgoingLocation) GetQueryString() Querystring {
 	toReturn := map[string]string(ol.GetBaseQueryString())
@@ -29,5 +59,21 @@ func (ol *OutgoingLocation) GetQueryString() Querystring {
 	toReturn["latitude"] = fmt.Sprint(ol.Latitude)
 	toReturn["longitude"] = fmt.Sprint(ol.Longitude)
 
+	if ol.HorizontalAccuracy != 0 {
+		toReturn["horizontal_accuracy"] = fmt.Sprint(ol.HorizontalAccuracy)
+	}
+
+	if ol.LivePeriod != 0 {
+		toReturn["live_period"] = fmt.Sprint(ol.LivePeriod)
+	}
+
+	if ol.Heading != 0 {
+		toReturn["heading"] = fmt.Sprint(ol.Heading)
+	}
+
+	if ol.ProximityAlertRadius != 0 {
+		toReturn["proximity_alert_radius"] = fmt.Sprint(ol.ProximityAlertRadius)
+	}
+
 	return Querystring(toReturn)
 }
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// OutgoingMediaGroup represents an outgoing group of photos, videos or documents, sent as an album
+type OutgoingMediaGroup struct {
+	OutgoingBase
+	Media []*InputMedia `json:"media"`
+}
+
+// NewOutgoingMediaGroup creates a new outgoing media group
+func NewOutgoingMediaGroup(recipient Recipient, media ...*InputMedia) *OutgoingMediaGroup {
+	return &OutgoingMediaGroup{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		Media: media,
+	}
+}
+
+// AddMedia adds media to the group
+func (og *OutgoingMediaGroup) AddMedia(media ...*InputMedia) *OutgoingMediaGroup {
+	og.Media = append(og.Media, media...)
+	return og
+}
+
+// GetFiles returns the paths of the files to upload, by the field names they are referenced as
+func (og *OutgoingMediaGroup) GetFiles() map[string]string {
+	toReturn := map[string]string{}
+	for i, media := range og.Media {
+		if media.IsUpload() {
+			toReturn[mediaFieldName(i)] = media.filePath
+		}
+	}
+	return toReturn
+}
+
+// GetQueryString returns a Querystring representing the media group
+func (og *OutgoingMediaGroup) GetQueryString() Querystring {
+	toReturn := map[string]string(og.GetBaseQueryString())
+
+	media := make([]InputMedia, 0, len(og.Media))
+	for i, m := range og.Media {
+		toSend := *m
+		if toSend.IsUpload() {
+			toSend.Media = "attach://" + mediaFieldName(i)
+		}
+		media = append(media, toSend)
+	}
+
+	b, err := json.Marshal(media)
+	if err != nil {
+		panic(err)
+	}
+	toReturn["media"] = string(b)
+
+	return Querystring(toReturn)
+}
+
+func mediaFieldName(i int) string {
+	return fmt.Sprint("file", i)
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOutgoingMediaGroupCaptionEntities(t *testing.T) {
+	caption := NewFormattedText().Text("a ").Bold("b")
+	og := NewOutgoingMediaGroup(NewChatRecipient(1),
+		NewInputMedia(InputMediaPhoto, "photo").SetFormattedCaption(caption),
+		NewInputMediaUpload(InputMediaVideo, "video.mp4"))
+
+	var media []map[string]interface{}
+	if err := json.Unmarshal([]byte(og.GetQueryString()["media"]), &media); err != nil {
+		t.Fatal(err)
+	}
+	if len(media) != 2 {
+		t.Fatalf("got %d media", len(media))
+	}
+
+	entities, _ := media[0]["caption_entities"].([]interface{})
+	if media[0]["caption"] != "a b" || len(entities) != 1 || entities[0].(map[string]interface{})["type"] != "bold" {
+		t.Errorf("unexpected caption %v with entities %v", media[0]["caption"], media[0]["caption_entities"])
+	}
+	if _, ok := media[1]["caption_entities"]; ok || media[1]["media"] != "attach://"+mediaFieldName(1) {
+		t.Errorf("unexpected second media %v", media[1])
+	}
+}
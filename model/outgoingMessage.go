@@ -3,9 +3,11 @@ package model
 // OutgoingMessage represents an outgoing message
 type OutgoingMessage struct {
 	OutgoingBase
-	Text                  string    `json:"text"`
-	DisableWebPagePreview bool      `json:"disable_web_page_preview,omitempty"`
-	ParseMode             ParseMode `json:"parse_mode,omitempty"`
+	Text                  string              `json:"text"`
+	DisableWebPagePreview bool                `json:"disable_web_page_preview,omitempty"`
+	LinkPreviewOptions    *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	ParseMode             ParseMode           `json:"parse_mode,omitempty"`
+	Entities              []MessageEntity     `json:"entities,omitempty"`
 }
 
 // Querystring is a type to represent querystring-applicable data
@@ -32,8 +34,81 @@ func (om *OutgoingMessage) SetMarkdown(to bool) *OutgoingMessage {
 	return om
 }
 
+// SetParseMode sets the ParseMode for the message (optional)
+func (om *OutgoingMessage) SetParseMode(to ParseMode) *OutgoingMessage {
+	om.ParseMode = to
+	return om
+}
+
+// SetFormattedText sets the text of the message to the formatted text.
+// The formatting is sent as entities, so the ParseMode is reset.
+func (om *OutgoingMessage) SetFormattedText(to *FormattedText) *OutgoingMessage {
+	om.Text, om.Entities = to.Entities()
+	om.ParseMode = ModeDefault
+	return om
+}
+
 // SetDisableWebPagePreview disables web page previews for the message (optional)
 func (om *OutgoingMessage) SetDisableWebPagePreview(to bool) *OutgoingMessage {
 	om.DisableWebPagePreview = to
 	return om
 }
+
+// SetLinkPreviewOptions sets how the link preview for the message is generated (optional)
+func (om *OutgoingMessage) SetLinkPreviewOptions(to LinkPreviewOptions) *OutgoingMessage {
+	om.LinkPreviewOptions = &to
+	return om
+}
+
+// Split splits the message into messages with texts of at most limit UTF-16 code units, see SplitText.
+// Only the first message replies to the message set by SetReplyToMessageID or SetReplyParameters, only the last message carries the
+// reply markup. If the text does not need to be split, a slice containing only the message itself is returned.
+func (om *OutgoingMessage) Split(limit int) []*OutgoingMessage {
+	chunks := splitText(om.Text, om.ParseMode, limit)
+	if len(chunks) < 2 {
+		return []*OutgoingMessage{om}
+	}
+
+	toReturn := make([]*OutgoingMessage, 0, len(chunks))
+	for i, chunk := range chunks {
+		part := *om
+		part.Text = chunk.text
+		part.Entities = clipEntities(om.Entities, chunk.offset, chunk.length)
+
+		if i > 0 {
+			part.ReplyToMessageID = 0
+			part.replyToMessageIDSet = false
+			part.ReplyParameters = nil
+		}
+		if i < len(chunks)-1 {
+			part.ReplyMarkup = nil
+			part.replyMarkupSet = false
+		}
+
+		toReturn = append(toReturn, &part)
+	}
+
+	return toReturn
+}
+
+// clipEntities returns the parts of the entities within the range given in UTF-16 code units, relative to offset
+func clipEntities(entities []MessageEntity, offset, length int) []MessageEntity {
+	var toReturn []MessageEntity
+	for _, e := range entities {
+		start, end := e.Offset, e.Offset+e.Length
+		if start < offset {
+			start = offset
+		}
+		if end > offset+length {
+			end = offset + length
+		}
+		if start >= end {
+			continue
+		}
+
+		e.Offset = start - offset
+		e.Length = end - start
+		toReturn = append(toReturn, e)
+	}
+	return toReturn
+}
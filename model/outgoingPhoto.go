@@ -3,7 +3,8 @@ package model
 // OutgoingPhoto represents an outgoing photo
 type OutgoingPhoto struct {
 	OutgoingBase
-	Caption string `json:"caption,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // NewOutgoingPhoto creates a new outgoing photo
@@ -21,13 +22,16 @@ func (op *OutgoingPhoto) SetCaption(to string) *OutgoingPhoto {
 	return op
 }
 
+// SetCaptionEntities sets the entities of the caption, for example those of a received message (optional)
+func (op *OutgoingPhoto) SetCaptionEntities(to []MessageEntity) *OutgoingPhoto {
+	op.CaptionEntities = to
+	return op
+}
+
 // GetQueryString returns a Querystring representing the photo
 func (op *OutgoingPhoto) GetQueryString() Querystring {
 	toReturn := map[string]string(op.GetBaseQueryString())
-
-	if op.Caption != "" {
-		toReturn["caption"] = op.Caption
-	}
+	addCaption(toReturn, op.Caption, op.CaptionEntities)
 
 	return Querystring(toReturn)
 }
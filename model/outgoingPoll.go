@@ -0,0 +1,88 @@
+package model
+
+// OutgoingPoll represents an outgoing poll or quiz
+type OutgoingPoll struct {
+	OutgoingBase
+	Question              string            `json:"question"`
+	Options               []InputPollOption `json:"options"`
+	IsAnonymous           bool              `json:"is_anonymous"`
+	Type                  PollKind          `json:"type,omitempty"`
+	AllowsMultipleAnswers bool              `json:"allows_multiple_answers,omitempty"`
+	CorrectOptionID       *int              `json:"correct_option_id,omitempty"`
+	Explanation           string            `json:"explanation,omitempty"`
+	ExplanationParseMode  ParseMode         `json:"explanation_parse_mode,omitempty"`
+	OpenPeriod            int               `json:"open_period,omitempty"`
+	CloseDate             int               `json:"close_date,omitempty"`
+	IsClosed              bool              `json:"is_closed,omitempty"`
+}
+
+// InputPollOption represents an answer option of an outgoing poll
+type InputPollOption struct {
+	Text string `json:"text"`
+}
+
+// NewOutgoingPoll creates a new outgoing, anonymous poll with two to ten options
+func NewOutgoingPoll(recipient Recipient, question string, options ...string) *OutgoingPoll {
+	toReturn := &OutgoingPoll{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		Question:    question,
+		IsAnonymous: true,
+		Type:        PollRegular,
+	}
+
+	for _, option := range options {
+		toReturn.Options = append(toReturn.Options, InputPollOption{Text: option})
+	}
+
+	return toReturn
+}
+
+// NewOutgoingQuiz creates a new outgoing, anonymous quiz with two to ten options.
+// correctOptionID is the index of the correct option.
+func NewOutgoingQuiz(recipient Recipient, question string, correctOptionID int, options ...string) *OutgoingPoll {
+	toReturn := NewOutgoingPoll(recipient, question, options...)
+	toReturn.Type = PollQuiz
+	toReturn.CorrectOptionID = &correctOptionID
+	return toReturn
+}
+
+// SetAnonymous sets whether the poll is anonymous, defaults to true (optional)
+func (op *OutgoingPoll) SetAnonymous(to bool) *OutgoingPoll {
+	op.IsAnonymous = to
+	return op
+}
+
+// SetAllowsMultipleAnswers sets whether multiple answers are allowed, ignored for quizzes (optional)
+func (op *OutgoingPoll) SetAllowsMultipleAnswers(to bool) *OutgoingPoll {
+	op.AllowsMultipleAnswers = to
+	return op
+}
+
+// SetExplanation sets the text shown when a user chooses an incorrect answer in a quiz (optional)
+func (op *OutgoingPoll) SetExplanation(to string, mode ParseMode) *OutgoingPoll {
+	op.Explanation = to
+	op.ExplanationParseMode = mode
+	return op
+}
+
+// SetOpenPeriod sets the time in seconds the poll will be active after creation, 5-600 (optional)
+// Only one of OpenPeriod and CloseDate can be set.
+func (op *OutgoingPoll) SetOpenPeriod(to int) *OutgoingPoll {
+	op.OpenPeriod = to
+	return op
+}
+
+// SetCloseDate sets the timestamp when the poll will be closed automatically (optional)
+// Only one of OpenPeriod and CloseDate can be set.
+func (op *OutgoingPoll) SetCloseDate(to int) *OutgoingPoll {
+	op.CloseDate = to
+	return op
+}
+
+// SetClosed sets whether the poll is sent closed (optional)
+func (op *OutgoingPoll) SetClosed(to bool) *OutgoingPoll {
+	op.IsClosed = to
+	return op
+}
@@ -0,0 +1,93 @@
+package model
+
+// OutgoingShippingQueryAnswer represents an outgoing answer to a ShippingQuery
+type OutgoingShippingQueryAnswer struct {
+	ShippingQueryID string           `json:"shipping_query_id"`
+	Ok              bool             `json:"ok"`
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
+	ErrorMessage    string           `json:"error_message,omitempty"`
+}
+
+// NewOutgoingShippingQueryAnswer creates a new answer offering the given shipping options
+func NewOutgoingShippingQueryAnswer(queryID string, options ...ShippingOption) *OutgoingShippingQueryAnswer {
+	return &OutgoingShippingQueryAnswer{
+		ShippingQueryID: queryID,
+		Ok:              true,
+		ShippingOptions: options,
+	}
+}
+
+// NewOutgoingShippingQueryError creates a new answer indicating that delivery to the address is not possible.
+// The error message is shown to the user.
+func NewOutgoingShippingQueryError(queryID, errorMessage string) *OutgoingShippingQueryAnswer {
+	return &OutgoingShippingQueryAnswer{
+		ShippingQueryID: queryID,
+		ErrorMessage:    errorMessage,
+	}
+}
+
+// OutgoingPreCheckoutQueryAnswer represents an outgoing answer to a PreCheckoutQuery
+type OutgoingPreCheckoutQueryAnswer struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	Ok                 bool   `json:"ok"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+// NewOutgoingPreCheckoutQueryAnswer creates a new answer confirming that the bot is ready to proceed with the order
+func NewOutgoingPreCheckoutQueryAnswer(queryID string) *OutgoingPreCheckoutQueryAnswer {
+	return &OutgoingPreCheckoutQueryAnswer{
+		PreCheckoutQueryID: queryID,
+		Ok:                 true,
+	}
+}
+
+// NewOutgoingPreCheckoutQueryError creates a new answer indicating that the order cannot be processed.
+// The error message is shown to the user.
+func NewOutgoingPreCheckoutQueryError(queryID, errorMessage string) *OutgoingPreCheckoutQueryAnswer {
+	return &OutgoingPreCheckoutQueryAnswer{
+		PreCheckoutQueryID: queryID,
+		ErrorMessage:       errorMessage,
+	}
+}
+
+// OutgoingCallbackQueryAnswer represents an outgoing answer to a CallbackQuery
+type OutgoingCallbackQueryAnswer struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`
+	ShowAlert       bool   `json:"show_alert,omitempty"`
+	URL             string `json:"url,omitempty"`
+	CacheTime       int    `json:"cache_time,omitempty"`
+}
+
+// NewOutgoingCallbackQueryAnswer creates a new answer to the callback query.
+// Without further options, the progress indicator on the button is removed and nothing is shown to the user.
+func NewOutgoingCallbackQueryAnswer(queryID string) *OutgoingCallbackQueryAnswer {
+	return &OutgoingCallbackQueryAnswer{
+		CallbackQueryID: queryID,
+	}
+}
+
+// SetText sets a text to be shown to the user, at most 200 characters
+func (oa *OutgoingCallbackQueryAnswer) SetText(to string) *OutgoingCallbackQueryAnswer {
+	oa.Text = to
+	return oa
+}
+
+// SetShowAlert sets whether the text is shown as an alert instead of a notification at the top of the chat
+func (oa *OutgoingCallbackQueryAnswer) SetShowAlert(to bool) *OutgoingCallbackQueryAnswer {
+	oa.ShowAlert = to
+	return oa
+}
+
+// SetURL sets a URL to be opened by the client.
+// For game buttons, this is the URL of the game.
+func (oa *OutgoingCallbackQueryAnswer) SetURL(to string) *OutgoingCallbackQueryAnswer {
+	oa.URL = to
+	return oa
+}
+
+// SetCacheTime sets the time in seconds the answer may be cached client-side
+func (oa *OutgoingCallbackQueryAnswer) SetCacheTime(to int) *OutgoingCallbackQueryAnswer {
+	oa.CacheTime = to
+	return oa
+}
@@ -6,13 +6,13 @@ import (
 
 // OutgoingUserProfilePhotosRequest represents a request for a users profile photos
 type OutgoingUserProfilePhotosRequest struct {
-	UserID int `json:"user_id"`
-	Offset int `json:"offset,omitempty"`
-	Limit  int `json:"limit,omitempty"`
+	UserID int64 `json:"user_id"`
+	Offset int   `json:"offset,omitempty"`
+	Limit  int   `json:"limit,omitempty"`
 }
 
 // NewOutgoingUserProfilePhotosRequest creates a new request for a users profile photos
-func NewOutgoingUserProfilePhotosRequest(userID int) *OutgoingUserProfilePhotosRequest {
+func NewOutgoingUserProfilePhotosRequest(userID int64) *OutgoingUserProfilePhotosRequest {
 	return &OutgoingUserProfilePhotosRequest{
 		UserID: userID,
 	}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+)
+
+// OutgoingVenue represents an outgoing venue
+type OutgoingVenue struct {
+	OutgoingBase
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	Title           string  `json:"title"`
+	Address         string  `json:"address"`
+	FoursquareID    string  `json:"foursquare_id,omitempty"`
+	FoursquareType  string  `json:"foursquare_type,omitempty"`
+	GooglePlaceID   string  `json:"google_place_id,omitempty"`
+	GooglePlaceType string  `json:"google_place_type,omitempty"`
+}
+
+// NewOutgoingVenue creates a new outgoing venue
+func NewOutgoingVenue(recipient Recipient, latitude, longitude float64, title, address string) *OutgoingVenue {
+	return &OutgoingVenue{
+		OutgoingBase: OutgoingBase{
+			Recipient: recipient,
+		},
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+		Address:   address,
+	}
+}
+
+// SetFoursquare sets the foursquare identifier and type of the venue (optional)
+func (ov *OutgoingVenue) SetFoursquare(id, typ string) *OutgoingVenue {
+	ov.FoursquareID = id
+	ov.FoursquareType = typ
+	return ov
+}
+
+// SetGooglePlace sets the Google Places identifier and type of the venue (optional)
+func (ov *OutgoingVenue) SetGooglePlace(id, typ string) *OutgoingVenue {
+	ov.GooglePlaceID = id
+	ov.GooglePlaceType = typ
+	return ov
+}
+
+// GetQueryString returns a Querystring representing the venue
+func (ov *OutgoingVenue) GetQueryString() Querystring {
+	toReturn := map[string]string(ov.GetBaseQueryString())
+
+	toReturn["latitude"] = fmt.Sprint(ov.Latitude)
+	toReturn["longitude"] = fmt.Sprint(ov.Longitude)
+	toReturn["title"] = ov.Title
+	toReturn["address"] = ov.Address
+
+	if ov.FoursquareID != "" {
+		toReturn["foursquare_id"] = ov.FoursquareID
+	}
+
+	if ov.FoursquareType != "" {
+		toReturn["foursquare_type"] = ov.FoursquareType
+	}
+
+	if ov.GooglePlaceID != "" {
+		toReturn["google_place_id"] = ov.GooglePlaceID
+	}
+
+	if ov.GooglePlaceType != "" {
+		toReturn["google_place_type"] = ov.GooglePlaceType
+	}
+
+	return Querystring(toReturn)
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestOutgoingContact(t *testing.T) {
+	vcard := "BEGIN:VCARD\nVERSION:3.0\nFN:Vlad\nEND:VCARD"
+
+	checkEncoding(t, "contact", NewOutgoingContact(NewChatRecipient(1), "+49123", "Vlad"), map[string]string{
+		"chat_id": "1", "phone_number": "+49123", "first_name": "Vlad", "last_name": "", "vcard": "",
+	})
+	checkEncoding(t, "contact with vCard", NewOutgoingContact(NewChatRecipient(1), "+49123", "Vlad").SetLastName("B").
+		SetVCard(vcard), map[string]string{
+		"phone_number": "+49123", "first_name": "Vlad", "last_name": "B", "vcard": vcard,
+	})
+}
+
+func TestOutgoingVenue(t *testing.T) {
+	checkEncoding(t, "venue", NewOutgoingVenue(NewChatRecipient(1), 52.5, 13.25, "Venue", "Street 1"), map[string]string{
+		"latitude": "52.5", "longitude": "13.25", "title": "Venue", "address": "Street 1",
+		"foursquare_id": "", "foursquare_type": "", "google_place_id": "", "google_place_type": "",
+	})
+	checkEncoding(t, "venue with IDs", NewOutgoingVenue(NewChatRecipient(1), 52.5, 13.25, "Venue", "Street 1").
+		SetFoursquare("4sq", "arts_entertainment/default").SetGooglePlace("ChIJ", "museum"), map[string]string{
+		"foursquare_id": "4sq", "foursquare_type": "arts_entertainment/default",
+		"google_place_id": "ChIJ", "google_place_type": "museum",
+	})
+}
+
+func TestOutgoingDice(t *testing.T) {
+	checkEncoding(t, "dice", NewOutgoingDice(NewChatRecipient(1)), map[string]string{"emoji": ""})
+	checkEncoding(t, "darts", NewOutgoingDice(NewChatRecipient(1)).SetEmoji(DiceEmojiDarts), map[string]string{"emoji": "🎯"})
+}
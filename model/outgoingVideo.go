@@ -7,8 +7,9 @@ import (
 // OutgoingVideo represents an outgoing video file
 type OutgoingVideo struct {
 	OutgoingBase
-	Duration int    `json:"duration,omitempty"`
-	Caption  string `json:"caption,omitempty"`
+	Duration        int             `json:"duration,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 }
 
 // NewOutgoingVideo creates a new outgoing video file
@@ -26,6 +27,12 @@ func (ov *OutgoingVideo) SetCaption(to string) *OutgoingVideo {
 	return ov
 }
 
+// SetCaptionEntities sets the entities of the caption, for example those of a received message (optional)
+func (ov *OutgoingVideo) SetCaptionEntities(to []MessageEntity) *OutgoingVideo {
+	ov.CaptionEntities = to
+	return ov
+}
+
 // SetDuration sets a duration for the video file (optional)
 func (ov *OutgoingVideo) SetDuration(to int) *OutgoingVideo {
 	ov.Duration = to
@@ -35,10 +42,7 @@ func (ov *OutgoingVideo) SetDuration(to int) *OutgoingVideo {
 // GetQueryString returns a Querystring representing the outgoing video file
 func (ov *OutgoingVideo) GetQueryString() Querystring {
 	toReturn := map[string]string(ov.GetBaseQueryString())
-
-	if ov.Caption != "" {
-		toReturn["caption"] = ov.Caption
-	}
+	addCaption(toReturn, ov.Caption, ov.CaptionEntities)
 
 	if ov.Duration != 0 {
 		toReturn["duration"] = fmt.Sprint(ov.Duration)
@@ -7,7 +7,9 @@ import (
 // OutgoingVoice represents an outgoing voice note
 type OutgoingVoice struct {
 	OutgoingBase
-	Duration int `json:"duration,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	Duration        int             `json:"duration,omitempty"`
 }
 
 // NewOutgoingVoice creates a new outgoing voice note
@@ -19,6 +21,18 @@ func NewOutgoingVoice(recipient Recipient) *OutgoingVoice {
 	}
 }
 
+// SetCaption sets a caption for the voice note (optional)
+func (ov *OutgoingVoice) SetCaption(to string) *OutgoingVoice {
+	ov.Caption = to
+	return ov
+}
+
+// SetCaptionEntities sets the entities of the caption, for example those of a received message (optional)
+func (ov *OutgoingVoice) SetCaptionEntities(to []MessageEntity) *OutgoingVoice {
+	ov.CaptionEntities = to
+	return ov
+}
+
 // SetDuration sets a duration of the voice note (optional)
 func (ov *OutgoingVoice) SetDuration(to int) *OutgoingVoice {
 	ov.Duration = to
@@ -28,6 +42,7 @@ func (ov *OutgoingVoice) SetDuration(to int) *OutgoingVoice {
 // GetQueryString returns a Querystring representing the outgoing voice note
 func (ov *OutgoingVoice) GetQueryString() Querystring {
 	toReturn := map[string]string(ov.GetBaseQueryString())
+	addCaption(toReturn, ov.Caption, ov.CaptionEntities)
 
 	if ov.Duration != 0 {
 		toReturn["duration"] = fmt.Sprint(ov.Duration)
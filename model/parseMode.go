@@ -1,10 +1,62 @@
 package model
 
+import (
+	"strings"
+)
+
 // ParseMode describes how a message should be parsed client-side
 type ParseMode string
 
-//ParseModes
+// ParseModes
 const (
-	ModeMarkdown = ParseMode("Markdown") // Parse as Markdown
-	ModeDefault  = ParseMode("")         //Parse as text
+	ModeMarkdown   = ParseMode("Markdown")   // Parse as legacy Markdown
+	ModeMarkdownV2 = ParseMode("MarkdownV2") // Parse as MarkdownV2
+	ModeHTML       = ParseMode("HTML")       // Parse as HTML
+	ModeDefault    = ParseMode("")           //Parse as text
 )
+
+var (
+	htmlEscaper       = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;")
+	markdownEscaper   = strings.NewReplacer("_", "\\_", "*", "\\*", "`", "\\`", "[", "\\[")
+	markdownV2Escaper = newEscaper("\\_*[]()~`>#+-=|{}.!")
+	markdownV2Code    = newEscaper("\\`")
+	markdownV2URL     = newEscaper("\\)")
+)
+
+// EscapeHTML escapes text so that it is displayed literally in messages sent with ModeHTML
+func EscapeHTML(text string) string {
+	return htmlEscaper.Replace(text)
+}
+
+// EscapeMarkdown escapes text so that it is displayed literally in messages sent with ModeMarkdown.
+// Note that legacy Markdown does not support escaping inside of entities.
+func EscapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// EscapeMarkdownV2 escapes text so that it is displayed literally in messages sent with ModeMarkdownV2
+func EscapeMarkdownV2(text string) string {
+	return markdownV2Escaper.Replace(text)
+}
+
+// Escape escapes text for the given ParseMode
+func Escape(mode ParseMode, text string) string {
+	switch mode {
+	case ModeHTML:
+		return EscapeHTML(text)
+	case ModeMarkdown:
+		return EscapeMarkdown(text)
+	case ModeMarkdownV2:
+		return EscapeMarkdownV2(text)
+	}
+	return text
+}
+
+// newEscaper creates a Replacer that prefixes each of the given characters with a backslash
+func newEscaper(chars string) *strings.Replacer {
+	var pairs []string
+	for _, c := range chars {
+		pairs = append(pairs, string(c), "\\"+string(c))
+	}
+	return strings.NewReplacer(pairs...)
+}
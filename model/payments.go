@@ -0,0 +1,77 @@
+package model
+
+// InvoiceLinkResponse represents the response sent by the API on a CreateInvoiceLink request
+type InvoiceLinkResponse struct {
+	BaseResponse
+	Link string `json:"result"`
+}
+
+// LabeledPrice represents a portion of the price for goods or services
+type LabeledPrice struct {
+	Label  string `json:"label"`  // portion label
+	Amount int    `json:"amount"` // price of the product in the smallest units of the currency
+}
+
+// Invoice contains basic information about an invoice
+type Invoice struct {
+	Title          string `json:"title"`           // product name
+	Description    string `json:"description"`     // product description
+	StartParameter string `json:"start_parameter"` // unique bot deep-linking parameter used to generate this invoice
+	Currency       string `json:"currency"`        // three-letter ISO 4217 currency code
+	TotalAmount    int    `json:"total_amount"`    // total price in the smallest units of the currency
+}
+
+// ShippingAddress represents a shipping address
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2 country code
+	State       string `json:"state"`        // state, if applicable
+	City        string `json:"city"`
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2"`
+	PostCode    string `json:"post_code"`
+}
+
+// OrderInfo represents information about an order
+type OrderInfo struct {
+	Name            *string          `json:"name"`             // user name
+	PhoneNumber     *string          `json:"phone_number"`     // user's phone number
+	Email           *string          `json:"email"`            // user email
+	ShippingAddress *ShippingAddress `json:"shipping_address"` // user shipping address
+}
+
+// ShippingOption represents one shipping option
+type ShippingOption struct {
+	ID     string         `json:"id"`     // shipping option identifier
+	Title  string         `json:"title"`  // option title
+	Prices []LabeledPrice `json:"prices"` // list of price portions
+}
+
+// SuccessfulPayment contains basic information about a successful payment
+type SuccessfulPayment struct {
+	Currency                string     `json:"currency"`                   // three-letter ISO 4217 currency code
+	TotalAmount             int        `json:"total_amount"`               // total price in the smallest units of the currency
+	InvoicePayload          string     `json:"invoice_payload"`            // bot specified invoice payload
+	ShippingOptionID        *string    `json:"shipping_option_id"`         // identifier of the shipping option chosen by the user
+	OrderInfo               *OrderInfo `json:"order_info"`                 // order information provided by the user
+	TelegramPaymentChargeID string     `json:"telegram_payment_charge_id"` // Telegram payment identifier
+	ProviderPaymentChargeID string     `json:"provider_payment_charge_id"` // provider payment identifier
+}
+
+// ShippingQuery contains information about an incoming shipping query, sent for flexible invoices
+type ShippingQuery struct {
+	ID              string          `json:"id"`               // unique query identifier
+	From            User            `json:"from"`             // user who sent the query
+	InvoicePayload  string          `json:"invoice_payload"`  // bot specified invoice payload
+	ShippingAddress ShippingAddress `json:"shipping_address"` // user specified shipping address
+}
+
+// PreCheckoutQuery contains information about an incoming pre-checkout query
+type PreCheckoutQuery struct {
+	ID               string     `json:"id"`                 // unique query identifier
+	From             User       `json:"from"`               // user who sent the query
+	Currency         string     `json:"currency"`           // three-letter ISO 4217 currency code
+	TotalAmount      int        `json:"total_amount"`       // total price in the smallest units of the currency
+	InvoicePayload   string     `json:"invoice_payload"`    // bot specified invoice payload
+	ShippingOptionID *string    `json:"shipping_option_id"` // identifier of the shipping option chosen by the user
+	OrderInfo        *OrderInfo `json:"order_info"`         // order information provided by the user
+}
@@ -0,0 +1,58 @@
+package model
+
+// PollKind is the type of a poll
+type PollKind string
+
+// Represents all the possible PollKinds
+const (
+	PollRegular PollKind = "regular"
+	PollQuiz    PollKind = "quiz"
+)
+
+// PollResponse represents the response sent by the API on a StopPoll request
+type PollResponse struct {
+	BaseResponse
+	Poll Poll `json:"result"`
+}
+
+// Poll contains information about a poll
+type Poll struct {
+	ID                    string           `json:"id"`                      // unique poll identifier
+	Question              string           `json:"question"`                // the poll question
+	Options               []PollOption     `json:"options"`                 // the answer options
+	TotalVoterCount       int              `json:"total_voter_count"`       // total number of users that voted in the poll
+	IsClosed              bool             `json:"is_closed"`               // whether the poll is closed
+	IsAnonymous           bool             `json:"is_anonymous"`            // whether the poll is anonymous
+	Type                  PollKind         `json:"type"`                    // the type of the poll
+	AllowsMultipleAnswers bool             `json:"allows_multiple_answers"` // whether the poll allows multiple answers
+	CorrectOptionID       *int             `json:"correct_option_id"`       // for quizzes, the index of the correct option, if known to the bot
+	Explanation           *string          `json:"explanation"`             // for quizzes, the text shown when a user chooses an incorrect answer
+	ExplanationEntities   *[]MessageEntity `json:"explanation_entities"`    // special entities in the explanation
+	OpenPeriod            *int             `json:"open_period"`             // time in seconds the poll will be active after creation
+	CloseDate             *int             `json:"close_date"`              // timestamp when the poll will be automatically closed
+}
+
+// PollOption contains information about one answer option in a poll
+type PollOption struct {
+	Text       string `json:"text"`        // the option text
+	VoterCount int    `json:"voter_count"` // number of users that voted for this option
+}
+
+// PollAnswer represents an answer of a user in a non-anonymous poll
+type PollAnswer struct {
+	PollID    string `json:"poll_id"`    // unique poll identifier
+	VoterChat *Chat  `json:"voter_chat"` // the chat that changed the answer, if the voter is anonymous
+	User      *User  `json:"user"`       // the user that changed the answer, if the voter isn't anonymous
+	OptionIDs []int  `json:"option_ids"` // the indices of the chosen options, empty if the vote was retracted
+}
+
+// VoterID returns the ID of the user or chat that answered
+func (pa PollAnswer) VoterID() int64 {
+	if pa.VoterChat != nil {
+		return pa.VoterChat.ID
+	}
+	if pa.User != nil {
+		return pa.User.ID
+	}
+	return 0
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"encoding/json"
+)
+
+// UnmarshalJSON unmarshals an update and retains the raw JSON
+func (u *Update) UnmarshalJSON(data []byte) error {
+	type plain Update
+	if err := json.Unmarshal(data, (*plain)(u)); err != nil {
+		return err
+	}
+	u.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// Extra returns the raw JSON value of a top-level field of the update, or nil if the field is not present.
+// Use this to access fields not (yet) modeled.
+func (u *Update) Extra(key string) json.RawMessage {
+	return extra(u.Raw, key)
+}
+
+// UnmarshalJSON unmarshals a message and retains the raw JSON
+func (m *Message) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &m.noReplyMessage); err != nil {
+		return err
+	}
+
+	reply := struct {
+		ReplyToMessage *noReplyMessage `json:"reply_to_message"`
+	}{}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return err
+	}
+	m.ReplyToMessage = reply.ReplyToMessage
+
+	return nil
+}
+
+// UnmarshalJSON unmarshals a message and retains the raw JSON
+func (m *noReplyMessage) UnmarshalJSON(data []byte) error {
+	type plain noReplyMessage
+	if err := json.Unmarshal(data, (*plain)(m)); err != nil {
+		return err
+	}
+	m.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// Extra returns the raw JSON value of a top-level field of the message, or nil if the field is not present.
+// Use this to access fields not (yet) modeled.
+func (m *noReplyMessage) Extra(key string) json.RawMessage {
+	return extra(m.Raw, key)
+}
+
+func extra(raw json.RawMessage, key string) json.RawMessage {
+	if raw == nil {
+		return nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	return fields[key]
+}
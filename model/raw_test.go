@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateExtra(t *testing.T) {
+	var u Update
+	data := `{"update_id": 7, "future_kind": {"answer": 42},
+		"message": {"message_id": 1, "date": 1700000000, "chat": {"id": 1, "type": "private"}, "text": "hi"}}`
+	if err := json.Unmarshal([]byte(data), &u); err != nil {
+		t.Fatal(err)
+	}
+
+	if u.ID != 7 || u.Kind() != MessageUpdate || u.Message.Text == nil || *u.Message.Text != "hi" {
+		t.Errorf("known fields were not decoded: %+v", u)
+	}
+	if string(u.Raw) != data {
+		t.Errorf("got raw %s", u.Raw)
+	}
+	if extra := string(u.Extra("future_kind")); extra != `{"answer": 42}` {
+		t.Errorf("got extra %s", extra)
+	}
+	if extra := u.Extra("missing"); extra != nil {
+		t.Errorf("got %s for a missing field", extra)
+	}
+	if extra := (&Update{}).Extra("future_kind"); extra != nil {
+		t.Errorf("got %s for an update without raw JSON", extra)
+	}
+}
+
+func TestMessageExtra(t *testing.T) {
+	const (
+		reply  = `{"message_id": 2, "date": 1700000000, "chat": {"id": 1, "type": "group"}, "reply_field": true}`
+		pinned = `{"message_id": 3, "date": 1700000000, "chat": {"id": 1, "type": "group"}, "pinned_field": true}`
+	)
+	var m Message
+	data := `{"message_id": 4, "date": 1700000000, "chat": {"id": 1, "type": "group"}, "text": "hi", "message_field": true,
+		"reply_to_message": ` + reply + `, "pinned_message": ` + pinned + `}`
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.ID != 4 || m.Text == nil || *m.Text != "hi" || m.ReplyToMessage.ID != 2 || m.PinnedMessage.ID != 3 {
+		t.Errorf("known fields were not decoded: %+v", m)
+	}
+	if string(m.Raw) != data || string(m.ReplyToMessage.Raw) != reply || string(m.PinnedMessage.Raw) != pinned {
+		t.Errorf("got raw %s, reply raw %s and pinned raw %s", m.Raw, m.ReplyToMessage.Raw, m.PinnedMessage.Raw)
+	}
+	if string(m.Extra("message_field")) != "true" || m.Extra("reply_field") != nil {
+		t.Errorf("got message extras %s and %s", m.Extra("message_field"), m.Extra("reply_field"))
+	}
+	if string(m.ReplyToMessage.Extra("reply_field")) != "true" || m.ReplyToMessage.Extra("message_field") != nil {
+		t.Errorf("got reply extras %s and %s", m.ReplyToMessage.Extra("reply_field"), m.ReplyToMessage.Extra("message_field"))
+	}
+	if string(m.PinnedMessage.Extra("pinned_field")) != "true" {
+		t.Errorf("got pinned extra %s", m.PinnedMessage.Extra("pinned_field"))
+	}
+	if m.Extra("missing") != nil {
+		t.Errorf("got %s for a missing field", m.Extra("missing"))
+	}
+}
@@ -0,0 +1,94 @@
+package model
+
+// ReactionKind is the type of a reaction
+type ReactionKind string
+
+// Represents all the possible ReactionKinds
+const (
+	ReactionEmoji       ReactionKind = "emoji"        // reactions with a regular emoji
+	ReactionCustomEmoji ReactionKind = "custom_emoji" // reactions with a custom emoji
+	ReactionPaid        ReactionKind = "paid"         // paid reactions, using Telegram Stars
+)
+
+// ReactionType represents a reaction.
+// ReactionTypes are comparable and can be used as map keys.
+type ReactionType struct {
+	Type          ReactionKind `json:"type"`
+	Emoji         string       `json:"emoji,omitempty"`           // the emoji, for ReactionEmoji
+	CustomEmojiID string       `json:"custom_emoji_id,omitempty"` // the custom emoji, for ReactionCustomEmoji
+}
+
+// NewEmojiReaction creates a new reaction with a regular emoji, for example "👍"
+func NewEmojiReaction(emoji string) ReactionType {
+	return ReactionType{
+		Type:  ReactionEmoji,
+		Emoji: emoji,
+	}
+}
+
+// NewCustomEmojiReaction creates a new reaction with a custom emoji
+func NewCustomEmojiReaction(customEmojiID string) ReactionType {
+	return ReactionType{
+		Type:          ReactionCustomEmoji,
+		CustomEmojiID: customEmojiID,
+	}
+}
+
+func (r ReactionType) String() string {
+	switch r.Type {
+	case ReactionEmoji:
+		return r.Emoji
+	case ReactionCustomEmoji:
+		return "custom_emoji:" + r.CustomEmojiID
+	}
+	return string(r.Type)
+}
+
+// ReactionCount represents the number of times a reaction was added to a message
+type ReactionCount struct {
+	Type       ReactionType `json:"type"`
+	TotalCount int          `json:"total_count"`
+}
+
+// MessageReactionUpdated represents a change of the reactions of a user on a message
+type MessageReactionUpdated struct {
+	Chat        Chat           `json:"chat"`         // the chat containing the message
+	MessageID   int            `json:"message_id"`   // the message the reactions were changed on
+	User        *User          `json:"user"`         // the user that changed the reactions, if the user isn't anonymous
+	ActorChat   *Chat          `json:"actor_chat"`   // the chat on behalf of which the reactions were changed, if the user is anonymous
+	Date        int            `json:"date"`         // timestamp of the change
+	OldReaction []ReactionType `json:"old_reaction"` // the previous reactions of the user
+	NewReaction []ReactionType `json:"new_reaction"` // the new reactions of the user
+}
+
+// MessageReactionCountUpdated represents a change of the anonymous reactions on a message
+type MessageReactionCountUpdated struct {
+	Chat      Chat            `json:"chat"`       // the chat containing the message
+	MessageID int             `json:"message_id"` // the message the reactions were changed on
+	Date      int             `json:"date"`       // timestamp of the change
+	Reactions []ReactionCount `json:"reactions"`  // the reactions now present on the message
+}
+
+// OutgoingReaction represents the reactions of the bot to be set on a message
+type OutgoingReaction struct {
+	Recipient Recipient      `json:"chat_id"`
+	MessageID int            `json:"message_id"`
+	Reaction  []ReactionType `json:"reaction,omitempty"`
+	IsBig     bool           `json:"is_big,omitempty"`
+}
+
+// NewOutgoingReaction creates new reactions of the bot on a message.
+// Without reactions, the reactions of the bot are removed. Bots can usually set only one reaction.
+func NewOutgoingReaction(recipient Recipient, messageID int, reactions ...ReactionType) *OutgoingReaction {
+	return &OutgoingReaction{
+		Recipient: recipient,
+		MessageID: messageID,
+		Reaction:  reactions,
+	}
+}
+
+// SetBig sets whether the reaction should be shown with a big animation (optional)
+func (or *OutgoingReaction) SetBig(to bool) *OutgoingReaction {
+	or.IsBig = to
+	return or
+}
@@ -4,12 +4,12 @@ import "fmt"
 
 // Recipient represents the recipient of a message
 type Recipient struct {
-	ChatID    *int
+	ChatID    *int64
 	ChannelID *string
 }
 
 // NewChatRecipient creates a new recipient for private or group chats
-func NewChatRecipient(chatID int) Recipient {
+func NewChatRecipient(chatID int64) Recipient {
 	return Recipient{
 		ChatID: &chatID,
 	}
@@ -35,6 +35,14 @@ func (r Recipient) isChannel() bool {
 	return r.ChannelID != nil
 }
 
+// querystringValue returns the recipient as a querystring value
+func (r Recipient) querystringValue() string {
+	if r.isChannel() {
+		return *r.ChannelID
+	}
+	return fmt.Sprint(*r.ChatID)
+}
+
 // MarshalJSON marshals the recipient to JSON
 func (r Recipient) MarshalJSON() ([]byte, error) {
 	toReturn := ""
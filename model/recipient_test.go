@@ -0,0 +1,89 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+const (
+	largeChatID int64 = -1001234567890123
+	largeUserID int64 = 1 << 33
+)
+
+func TestUpdateLargeIDs(t *testing.T) {
+	data := `{
+		"update_id": 1,
+		"message": {
+			"message_id": 2,
+			"date": 1700000000,
+			"chat": {"id": -1001234567890123, "type": "supergroup", "title": "Large"},
+			"from": {"id": 8589934592, "first_name": "Large"},
+			"text": "hi",
+			"migrate_to_chat_id": -1001234567890123
+		}
+	}`
+
+	var u Update
+	if err := json.Unmarshal([]byte(data), &u); err != nil {
+		t.Fatal(err)
+	}
+	if u.Message.Chat.ID != largeChatID {
+		t.Errorf("chat ID: got %d, want %d", u.Message.Chat.ID, largeChatID)
+	}
+	if u.Message.From.ID != largeUserID {
+		t.Errorf("user ID: got %d, want %d", u.Message.From.ID, largeUserID)
+	}
+	if u.Message.MigrateToChatID == nil || *u.Message.MigrateToChatID != largeChatID {
+		t.Errorf("migrate_to_chat_id: got %v, want %d", u.Message.MigrateToChatID, largeChatID)
+	}
+	if u.Sender().ID != largeUserID {
+		t.Errorf("sender ID: got %d, want %d", u.Sender().ID, largeUserID)
+	}
+}
+
+func TestRecipientLargeIDs(t *testing.T) {
+	for _, id := range []int64{largeChatID, largeUserID, -largeUserID} {
+		r := NewChatRecipient(id)
+
+		b, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded int64
+		if err = json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != id {
+			t.Errorf("JSON: got %s, want %d", b, id)
+		}
+
+		parsed, err := strconv.ParseInt(r.querystringValue(), 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != id {
+			t.Errorf("querystring: got %s, want %d", r.querystringValue(), id)
+		}
+	}
+
+	chat := Chat{ID: largeChatID}
+	if got := NewRecipientFromChat(chat).querystringValue(); got != "-1001234567890123" {
+		t.Errorf("querystring from chat: got %s", got)
+	}
+}
+
+func TestRecipientChannel(t *testing.T) {
+	r := NewChannelRecipient("@channel")
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"@channel"` {
+		t.Errorf("JSON: got %s", b)
+	}
+	if r.querystringValue() != "@channel" {
+		t.Errorf("querystring: got %s", r.querystringValue())
+	}
+}
@@ -1,9 +1,52 @@
 package model
 
+import (
+	"encoding/json"
+)
+
 // ReplyKeyboardMarkup represents a custom keyboard with reply options to be presented to clients
 type ReplyKeyboardMarkup struct {
-	Keyboard        [][]string `json:"keyboard"` // slice of keyboard lines
-	ResizeKeyboard  bool       `json:"resize_keyboard"`
-	OneTimeKeyboard bool       `json:"one_time_keyboard"`
-	Selective       bool       `json:"selective"`
+	Keyboard        [][]string         `json:"keyboard"` // slice of keyboard lines
+	Buttons         [][]KeyboardButton `json:"-"`        // slice of keyboard lines with special buttons, replaces Keyboard if set
+	ResizeKeyboard  bool               `json:"resize_keyboard"`
+	OneTimeKeyboard bool               `json:"one_time_keyboard"`
+	Selective       bool               `json:"selective"`
+}
+
+// KeyboardButton represents a button of a custom keyboard
+type KeyboardButton struct {
+	Text   string      `json:"text"`              // label text on the button, sent as a message when the button is pressed
+	WebApp *WebAppInfo `json:"web_app,omitempty"` // Web App to be launched when the button is pressed, only in private chats
+}
+
+// NewKeyboardButton creates a new button that sends its text
+func NewKeyboardButton(text string) KeyboardButton {
+	return KeyboardButton{
+		Text: text,
+	}
+}
+
+// NewKeyboardButtonWebApp creates a new button that launches the Web App at the URL.
+// The Web App can send data back to the bot, which is received as a message of WebAppDataType.
+func NewKeyboardButtonWebApp(text, url string) KeyboardButton {
+	return KeyboardButton{
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// MarshalJSON marshals the keyboard to JSON, using Buttons instead of Keyboard if set
+func (rm ReplyKeyboardMarkup) MarshalJSON() ([]byte, error) {
+	type plain ReplyKeyboardMarkup
+	if rm.Buttons == nil {
+		return json.Marshal(plain(rm))
+	}
+
+	return json.Marshal(struct {
+		plain
+		Keyboard [][]KeyboardButton `json:"keyboard"`
+	}{
+		plain:    plain(rm),
+		Keyboard: rm.Buttons,
+	})
 }
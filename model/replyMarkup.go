@@ -5,6 +5,7 @@ type ReplyMarkup interface {
 	replyMarkup()
 }
 
-func (ReplyKeyboardHide) replyMarkup()   {}
-func (ReplyKeyboardMarkup) replyMarkup() {}
-func (ForceReply) replyMarkup()          {}
+func (ReplyKeyboardHide) replyMarkup()    {}
+func (ReplyKeyboardMarkup) replyMarkup()  {}
+func (ForceReply) replyMarkup()           {}
+func (InlineKeyboardMarkup) replyMarkup() {}
@@ -0,0 +1,46 @@
+package model
+
+// ReplyParameters describes the message a message replies to
+type ReplyParameters struct {
+	MessageID                int             `json:"message_id"`
+	Recipient                *Recipient      `json:"chat_id,omitempty"`
+	AllowSendingWithoutReply bool            `json:"allow_sending_without_reply,omitempty"`
+	Quote                    string          `json:"quote,omitempty"`
+	QuoteParseMode           ParseMode       `json:"quote_parse_mode,omitempty"`
+	QuoteEntities            []MessageEntity `json:"quote_entities,omitempty"`
+	QuotePosition            int             `json:"quote_position,omitempty"`
+}
+
+// NewReplyParameters creates new reply parameters replying to the message with ID messageID
+func NewReplyParameters(messageID int) *ReplyParameters {
+	return &ReplyParameters{
+		MessageID: messageID,
+	}
+}
+
+// SetRecipient sets the chat the replied message is in, if it is not the chat the message is sent to (optional)
+func (rp *ReplyParameters) SetRecipient(to Recipient) *ReplyParameters {
+	rp.Recipient = &to
+	return rp
+}
+
+// SetAllowSendingWithoutReply sets whether the message should be sent even if the replied message is not found
+// (optional)
+func (rp *ReplyParameters) SetAllowSendingWithoutReply(to bool) *ReplyParameters {
+	rp.AllowSendingWithoutReply = to
+	return rp
+}
+
+// SetQuote sets the part of the replied message to quote, starting at position in UTF-16 code units (optional)
+// The quote must be an exact substring of the replied message, including formatting.
+func (rp *ReplyParameters) SetQuote(quote string, position int) *ReplyParameters {
+	rp.Quote = quote
+	rp.QuotePosition = position
+	return rp
+}
+
+// SetQuoteParseMode sets the ParseMode for the quote (optional)
+func (rp *ReplyParameters) SetQuoteParseMode(to ParseMode) *ReplyParameters {
+	rp.QuoteParseMode = to
+	return rp
+}
@@ -0,0 +1,337 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxMessageLength is the maximum length of a text message, in UTF-16 code units after entity parsing
+const MaxMessageLength = 4096
+
+// break levels, higher levels are preferred when splitting text
+const (
+	breakNone = iota
+	breakWord
+	breakLine
+	breakParagraph
+)
+
+// marker is a formatting construct that was opened, but not yet closed
+type marker struct {
+	key   string // identifies the marker, used to match closing markup
+	open  string // markup to reopen the marker
+	close string // markup to close the marker
+}
+
+// textUnit is an atomic piece of formatted text, i.e. one character, escape sequence or piece of markup
+type textUnit struct {
+	raw     string   // the unit as contained in the formatted text
+	length  int      // length of the unit after entity parsing in UTF-16 code units, 0 for markup
+	closes  bool     // whether the unit is markup closing a marker
+	markers []marker // the markers open after this unit, shared between units and never modified
+}
+
+// textChunk is a part of a split text
+type textChunk struct {
+	text   string
+	offset int // offset of the chunk after entity parsing in UTF-16 code units
+	length int // length of the chunk after entity parsing in UTF-16 code units
+}
+
+// SplitText splits a text formatted according to mode into parts of at most limit UTF-16 code units after entity
+// parsing. Texts are split on paragraph, line and word boundaries, if possible.
+// Formatting open at a split, for example a code block, is closed at the end of the part and reopened in the next.
+func SplitText(text string, mode ParseMode, limit int) []string {
+	var toReturn []string
+	for _, chunk := range splitText(text, mode, limit) {
+		toReturn = append(toReturn, chunk.text)
+	}
+	return toReturn
+}
+
+func splitText(text string, mode ParseMode, limit int) []textChunk {
+	var units []textUnit
+	switch mode {
+	case ModeHTML:
+		units = tokenizeHTML(text)
+	case ModeMarkdown:
+		units = tokenizeMarkdown(text, false)
+	case ModeMarkdownV2:
+		units = tokenizeMarkdown(text, true)
+	default:
+		units = tokenizePlain(text)
+	}
+
+	var toReturn []textChunk
+	var open []marker
+	offset := 0
+
+	for i := 0; i < len(units); {
+		length, lengthAtCut := 0, 0
+		cut, cutLevel := -1, -1
+
+		j := i
+		for ; j < len(units); j++ {
+			if length+units[j].length > limit && cut != -1 {
+				break
+			}
+			length += units[j].length
+			if units[j].length == 0 {
+				continue
+			}
+			if level := breakLevel(units, j); level >= cutLevel {
+				cut, cutLevel, lengthAtCut = j, level, length
+			}
+		}
+
+		if j == len(units) {
+			cut, lengthAtCut = j-1, length
+		}
+		// closing markup directly after the cut belongs to this chunk, there is nothing to reopen
+		for cut+1 < len(units) && units[cut+1].closes {
+			cut++
+		}
+
+		chunk := strings.Builder{}
+		for _, m := range open {
+			chunk.WriteString(m.open)
+		}
+		for _, u := range units[i : cut+1] {
+			chunk.WriteString(u.raw)
+		}
+		open = units[cut].markers
+		for k := len(open) - 1; k >= 0; k-- {
+			chunk.WriteString(open[k].close)
+		}
+
+		toReturn = append(toReturn, textChunk{text: chunk.String(), offset: offset, length: lengthAtCut})
+		offset += lengthAtCut
+		i = cut + 1
+	}
+
+	return toReturn
+}
+
+// breakLevel determines how well suited the position after units[i] is for splitting
+func breakLevel(units []textUnit, i int) int {
+	switch units[i].raw {
+	case "\n":
+		if i > 0 && units[i-1].raw == "\n" {
+			return breakParagraph
+		}
+		return breakLine
+	case " ", "\t":
+		return breakWord
+	}
+	return breakNone
+}
+
+func tokenizePlain(text string) []textUnit {
+	var toReturn []textUnit
+	for _, r := range text {
+		toReturn = append(toReturn, textUnit{raw: string(r), length: utf16Len(r)})
+	}
+	return toReturn
+}
+
+func tokenizeHTML(text string) []textUnit {
+	var toReturn []textUnit
+	var open []marker
+
+	for i := 0; i < len(text); {
+		rest := text[i:]
+		unit := textUnit{}
+
+		if end := strings.IndexByte(rest, '>'); rest[0] == '<' && end != -1 {
+			tag := rest[:end+1]
+			unit.raw = tag
+			if strings.HasPrefix(tag, "</") {
+				open = removeMarker(open, htmlTagName(tag[2:]))
+				unit.closes = true
+			} else {
+				name := htmlTagName(tag[1:])
+				open = pushMarker(open, marker{key: name, open: tag, close: "</" + name + ">"})
+			}
+		} else if entity, length := htmlEntity(rest); entity != "" {
+			unit.raw = entity
+			unit.length = length
+		} else {
+			r, size := utf8.DecodeRuneInString(rest)
+			unit.raw = rest[:size]
+			unit.length = utf16Len(r)
+		}
+
+		unit.markers = open
+		toReturn = append(toReturn, unit)
+		i += len(unit.raw)
+	}
+
+	return toReturn
+}
+
+// htmlEntity returns the entity text starts with and its length after entity parsing in UTF-16 code units.
+// Only the named entities supported by Telegram and numeric entities are recognized, "" is returned otherwise.
+func htmlEntity(text string) (string, int) {
+	end := strings.IndexByte(text, ';')
+	if text[0] != '&' || end == -1 {
+		return "", 0
+	}
+
+	entity := text[:end+1]
+	switch entity {
+	case "&lt;", "&gt;", "&amp;", "&quot;":
+		return entity, 1
+	}
+
+	if !strings.HasPrefix(entity, "&#") {
+		return "", 0
+	}
+	digits, base := entity[2:end], 10
+	if strings.HasPrefix(digits, "x") || strings.HasPrefix(digits, "X") {
+		digits, base = digits[1:], 16
+	}
+	r, err := strconv.ParseUint(digits, base, 32)
+	if err != nil || r > utf8.MaxRune {
+		return "", 0
+	}
+	return entity, utf16Len(rune(r))
+}
+
+func htmlTagName(tag string) string {
+	end := strings.IndexAny(tag, " \t\n>")
+	if end == -1 {
+		return tag
+	}
+	return tag[:end]
+}
+
+func tokenizeMarkdown(text string, v2 bool) []textUnit {
+	var toReturn []textUnit
+	var open []marker
+	linkEnds := map[int]string{}
+
+	toggle := func(key string) bool {
+		for _, m := range open {
+			if m.key == key {
+				open = removeMarker(open, key)
+				return true
+			}
+		}
+		open = pushMarker(open, marker{key: key, open: key, close: key})
+		return false
+	}
+
+	for i := 0; i < len(text); {
+		rest := text[i:]
+		inCode := len(open) > 0 && (open[len(open)-1].key == "`" || open[len(open)-1].key == "```")
+		unit := textUnit{}
+
+		switch {
+		case linkEnds[i] != "":
+			unit.raw = linkEnds[i]
+			unit.closes = true
+			open = removeMarker(open, "[")
+		case rest[0] == '\\' && len(rest) > 1 && (v2 || !inCode):
+			r, size := utf8.DecodeRuneInString(rest[1:])
+			unit.raw = rest[:1+size]
+			unit.length = utf16Len(r)
+		case strings.HasPrefix(rest, "```") && (!inCode || open[len(open)-1].key == "```"):
+			if inCode {
+				unit.raw = "```"
+				unit.closes = toggle("```")
+				break
+			}
+			unit.raw = "```"
+			if end := strings.IndexByte(rest, '\n'); end != -1 && !strings.ContainsAny(rest[3:end], " `") {
+				unit.raw = rest[:end+1]
+			}
+			open = pushMarker(open, marker{key: "```", open: unit.raw, close: "```"})
+		case rest[0] == '`':
+			unit.raw = "`"
+			unit.closes = toggle("`")
+		case inCode:
+			r, size := utf8.DecodeRuneInString(rest)
+			unit.raw = rest[:size]
+			unit.length = utf16Len(r)
+		case v2 && (strings.HasPrefix(rest, "__") || strings.HasPrefix(rest, "||")):
+			unit.raw = rest[:2]
+			unit.closes = toggle(unit.raw)
+		case rest[0] == '*' || rest[0] == '_' || (v2 && rest[0] == '~'):
+			unit.raw = rest[:1]
+			unit.closes = toggle(unit.raw)
+		case rest[0] == '[' && markdownLinkEnd(rest, v2) != -1:
+			textEnd := markdownLinkEnd(rest, v2)
+			urlEnd := markdownURLEnd(rest[textEnd:], v2)
+			linkEnds[i+textEnd] = rest[textEnd : textEnd+urlEnd+1]
+			unit.raw = "["
+			open = pushMarker(open, marker{key: "[", open: "[", close: linkEnds[i+textEnd]})
+		default:
+			r, size := utf8.DecodeRuneInString(rest)
+			unit.raw = rest[:size]
+			unit.length = utf16Len(r)
+		}
+
+		unit.markers = open
+		toReturn = append(toReturn, unit)
+		i += len(unit.raw)
+	}
+
+	return toReturn
+}
+
+// markdownLinkEnd returns the index of the "](" ending the text of the link starting at text[0], or -1 if text does
+// not start with a complete link
+func markdownLinkEnd(text string, v2 bool) int {
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			if v2 {
+				i++
+			}
+		case '[':
+			return -1
+		case ']':
+			if strings.HasPrefix(text[i:], "](") && markdownURLEnd(text[i:], v2) != -1 {
+				return i
+			}
+			return -1
+		}
+	}
+	return -1
+}
+
+// markdownURLEnd returns the index of the ")" ending the URL of a link, where text starts with the "](" preceding the
+// URL, or -1 if the URL is not terminated. In MarkdownV2, escaped characters do not end the URL.
+func markdownURLEnd(text string, v2 bool) int {
+	for i := 2; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			if v2 {
+				i++
+			}
+		case ')':
+			return i
+		}
+	}
+	return -1
+}
+
+// pushMarker returns a new slice containing the markers and m
+func pushMarker(markers []marker, m marker) []marker {
+	toReturn := make([]marker, len(markers), len(markers)+1)
+	copy(toReturn, markers)
+	return append(toReturn, m)
+}
+
+// removeMarker returns a new slice containing the markers without the last one with the given key
+func removeMarker(markers []marker, key string) []marker {
+	for i := len(markers) - 1; i >= 0; i-- {
+		if markers[i].key == key {
+			toReturn := make([]marker, 0, len(markers)-1)
+			toReturn = append(toReturn, markers[:i]...)
+			return append(toReturn, markers[i+1:]...)
+		}
+	}
+	return markers
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitTextMarkdownV2EscapedURL(t *testing.T) {
+	text := `[abc def ghi](https://en.wikipedia.org/wiki/Go_\(programming_language\)) tail`
+	want := []string{
+		`[abc ](https://en.wikipedia.org/wiki/Go_\(programming_language\))`,
+		`[def ](https://en.wikipedia.org/wiki/Go_\(programming_language\))`,
+		`[ghi](https://en.wikipedia.org/wiki/Go_\(programming_language\)) `,
+		`tail`,
+	}
+
+	if got := SplitText(text, ModeMarkdownV2, 4); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSplitTextHTMLEntities(t *testing.T) {
+	tests := []struct {
+		text   string
+		length int
+	}{
+		{"&lt;&gt;&amp;&quot;", 4},
+		{"&#65;&#x42;", 2},
+		{"&#128512;", 2},
+		{"&foo;", 5},
+		{"&nbsp;", 6},
+		{"&#;&#x;", 7},
+		{"a & b;", 6},
+	}
+
+	for _, tt := range tests {
+		chunks := splitText(tt.text, ModeHTML, MaxMessageLength)
+		if len(chunks) != 1 || chunks[0].length != tt.length {
+			t.Errorf("%q: got %+v, want one chunk of length %d", tt.text, chunks, tt.length)
+		}
+	}
+}
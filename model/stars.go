@@ -0,0 +1,77 @@
+package model
+
+// CurrencyStars is the currency code for payments in Telegram Stars
+const CurrencyStars = "XTR"
+
+// TransactionPartnerType is the type of a TransactionPartner
+type TransactionPartnerType string
+
+// Represents all the possible TransactionPartnerTypes
+const (
+	PartnerUser        TransactionPartnerType = "user"         // a user
+	PartnerFragment    TransactionPartnerType = "fragment"     // Fragment, for withdrawals
+	PartnerTelegramAds TransactionPartnerType = "telegram_ads" // the Telegram Ads platform
+	PartnerTelegramAPI TransactionPartnerType = "telegram_api" // paid broadcasts via the Bot API
+	PartnerOther       TransactionPartnerType = "other"        // an unknown transaction partner
+)
+
+// StarTransactionsResponse represents the response sent by the API on a GetStarTransactions request
+type StarTransactionsResponse struct {
+	BaseResponse
+	StarTransactions StarTransactions `json:"result"`
+}
+
+// StarTransactions contains a list of Telegram Star transactions
+type StarTransactions struct {
+	Transactions []StarTransaction `json:"transactions"`
+}
+
+// StarTransaction describes a Telegram Star transaction
+type StarTransaction struct {
+	ID             string              `json:"id"`              // unique identifier, coincides with the telegram_payment_charge_id of SuccessfulPayment for payments from users
+	Amount         int                 `json:"amount"`          // number of Telegram Stars transferred
+	NanostarAmount *int                `json:"nanostar_amount"` // number of 1/1000000000 shares of Telegram Stars transferred
+	Date           int                 `json:"date"`            // timestamp of the transaction
+	Source         *TransactionPartner `json:"source"`          // source of an incoming transaction
+	Receiver       *TransactionPartner `json:"receiver"`        // receiver of an outgoing transaction, for example a refund
+}
+
+// IsIncoming checks if the transaction was paid to the bot
+func (st StarTransaction) IsIncoming() bool {
+	return st.Source != nil
+}
+
+// TransactionPartner describes the source or receiver of a Telegram Star transaction
+type TransactionPartner struct {
+	Type           TransactionPartnerType `json:"type"`            // type of the transaction partner
+	User           *User                  `json:"user"`            // for users, information about the user
+	InvoicePayload *string                `json:"invoice_payload"` // for users, the bot specified invoice payload
+}
+
+// NewStarsInvoiceDetails creates new invoice details for a payment of amount Telegram Stars
+func NewStarsInvoiceDetails(title, description, payload string, amount int) *InvoiceDetails {
+	return NewInvoiceDetails(title, description, payload, "", CurrencyStars, LabeledPrice{Label: title, Amount: amount})
+}
+
+// OutgoingStarTransactionsRequest represents a request for the bots Telegram Star transactions
+type OutgoingStarTransactionsRequest struct {
+	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty"`
+}
+
+// NewOutgoingStarTransactionsRequest creates a new request for the bots Telegram Star transactions
+func NewOutgoingStarTransactionsRequest() *OutgoingStarTransactionsRequest {
+	return &OutgoingStarTransactionsRequest{}
+}
+
+// SetOffset sets the number of transactions to skip (optional)
+func (or *OutgoingStarTransactionsRequest) SetOffset(to int) *OutgoingStarTransactionsRequest {
+	or.Offset = to
+	return or
+}
+
+// SetLimit sets the maximum number of transactions to retrieve, 1-100, defaults to 100 (optional)
+func (or *OutgoingStarTransactionsRequest) SetLimit(to int) *OutgoingStarTransactionsRequest {
+	or.Limit = to
+	return or
+}
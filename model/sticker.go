@@ -1,9 +1,66 @@
 package model
 
+// StickerKind is the type of a sticker or sticker set
+type StickerKind string
+
+// Represents all the possible StickerKinds
+const (
+	StickerRegular     StickerKind = "regular"      // regular stickers
+	StickerMask        StickerKind = "mask"         // masks placed on photos
+	StickerCustomEmoji StickerKind = "custom_emoji" // custom emoji, usable in messages
+)
+
+// StickerFormat is the format of a sticker file
+type StickerFormat string
+
+// Represents all the possible StickerFormats
+const (
+	StickerFormatStatic   StickerFormat = "static"   // .WEBP or .PNG images
+	StickerFormatAnimated StickerFormat = "animated" // .TGS animations
+	StickerFormatVideo    StickerFormat = "video"    // .WEBM videos
+)
+
+// StickerResponse represents the response sent by the API on requests returning stickers
+type StickerResponse struct {
+	BaseResponse
+	Stickers []Sticker `json:"result"`
+}
+
+// StickerSetResponse represents the response sent by the API on a GetStickerSet request
+type StickerSetResponse struct {
+	BaseResponse
+	StickerSet StickerSet `json:"result"`
+}
+
 // Sticker represents a sticker
 type Sticker struct {
 	FileBase
-	Width     int       `json:"width"`
-	Height    int       `json:"height"`
-	Thumbnail PhotoSize `json:"thumb"`
+	Width         int         `json:"width"`
+	Height        int         `json:"height"`
+	Thumbnail     PhotoSize   `json:"thumbnail"`
+	Type          StickerKind `json:"type"`            // type of the sticker
+	IsAnimated    bool        `json:"is_animated"`     // whether the sticker is animated
+	IsVideo       bool        `json:"is_video"`        // whether the sticker is a video sticker
+	Emoji         *string     `json:"emoji"`           // emoji associated with the sticker
+	SetName       *string     `json:"set_name"`        // name of the sticker set the sticker belongs to
+	CustomEmojiID *string     `json:"custom_emoji_id"` // for custom emoji stickers, the unique identifier of the custom emoji
+}
+
+// Format determines the format of the sticker
+func (s Sticker) Format() StickerFormat {
+	if s.IsAnimated {
+		return StickerFormatAnimated
+	} else if s.IsVideo {
+		return StickerFormatVideo
+	}
+	return StickerFormatStatic
+}
+
+// StickerSet represents a sticker set
+type StickerSet struct {
+	Name        string      `json:"name"`         // sticker set name
+	Title       string      `json:"title"`        // sticker set title
+	StickerType StickerKind `json:"sticker_type"` // type of the stickers in the set
+	Stickers    []Sticker   `json:"stickers"`     // all stickers of the set
+	Thumbnail   *PhotoSize  `json:"thumbnail"`    // sticker set thumbnail
 }
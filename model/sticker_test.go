@@ -0,0 +1,25 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStickerUnmarshal(t *testing.T) {
+	data := `{
+		"file_id": "sticker", "file_unique_id": "s", "width": 512, "height": 512, "type": "regular",
+		"is_animated": false, "is_video": true, "emoji": "👍", "set_name": "set",
+		"thumbnail": {"file_id": "thumb", "file_unique_id": "t", "width": 128, "height": 128, "file_size": 1024}
+	}`
+
+	var s Sticker
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Thumbnail.ID != "thumb" || s.Thumbnail.Width != 128 || s.Thumbnail.Size != 1024 {
+		t.Errorf("unexpected thumbnail %+v", s.Thumbnail)
+	}
+	if s.Type != StickerRegular || !s.IsVideo || s.Emoji == nil || *s.Emoji != "👍" {
+		t.Errorf("unexpected sticker %+v", s)
+	}
+}
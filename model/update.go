@@ -1,6 +1,9 @@
 package model
 
-import "sort"
+import (
+	"encoding/json"
+	"sort"
+)
 
 // UpdateResponse represents the response sent by the API for a GetUpdates request
 type UpdateResponse struct {
@@ -20,8 +23,133 @@ func (resp *UpdateResponse) Sort() {
 	sort.Sort(ByID(resp.Update))
 }
 
-// Update represents an incoming update
+// Update represents an incoming update.
+// At most one of the optional fields is set, use Kind to determine which.
 type Update struct {
-	ID      int     `json:"update_id"`
-	Message Message `json:"message"`
+	ID                   int                          `json:"update_id"`
+	Raw                  json.RawMessage              `json:"-"`                      // the raw JSON the update was unmarshaled from
+	Message              Message                      `json:"message"`                // new incoming message, only valid for MessageUpdate
+	EditedMessage        *Message                     `json:"edited_message"`         // new version of a message that was edited
+	ChannelPost          *Message                     `json:"channel_post"`           // new incoming channel post
+	EditedChannelPost    *Message                     `json:"edited_channel_post"`    // new version of a channel post that was edited
+	Poll                 *Poll                        `json:"poll"`                   // new poll state, only for stopped polls and polls sent by the bot
+	PollAnswer           *PollAnswer                  `json:"poll_answer"`            // a changed answer in a non-anonymous poll
+	ShippingQuery        *ShippingQuery               `json:"shipping_query"`         // an incoming shipping query, only for flexible invoices
+	PreCheckoutQuery     *PreCheckoutQuery            `json:"pre_checkout_query"`     // an incoming pre-checkout query
+	CallbackQuery        *CallbackQuery               `json:"callback_query"`         // an incoming callback query from a button of an inline keyboard
+	MyChatMember         *ChatMemberUpdated           `json:"my_chat_member"`         // the bot's chat member status was updated
+	ChatMember           *ChatMemberUpdated           `json:"chat_member"`            // a chat member's status was updated, only if explicitly allowed
+	ChatJoinRequest      *ChatJoinRequest             `json:"chat_join_request"`      // a request to join a chat administrated by the bot
+	MessageReaction      *MessageReactionUpdated      `json:"message_reaction"`       // a user changed their reactions on a message, only if explicitly allowed
+	MessageReactionCount *MessageReactionCountUpdated `json:"message_reaction_count"` // the anonymous reactions on a message changed, only if explicitly allowed
+}
+
+// Kind determines the kind of the update
+func (u *Update) Kind() UpdateKind {
+	if u.EditedMessage != nil {
+		return EditedMessageUpdate
+	} else if u.ChannelPost != nil {
+		return ChannelPostUpdate
+	} else if u.EditedChannelPost != nil {
+		return EditedChannelPostUpdate
+	} else if u.Poll != nil {
+		return PollUpdate
+	} else if u.PollAnswer != nil {
+		return PollAnswerUpdate
+	} else if u.ShippingQuery != nil {
+		return ShippingQueryUpdate
+	} else if u.PreCheckoutQuery != nil {
+		return PreCheckoutQueryUpdate
+	} else if u.MyChatMember != nil {
+		return MyChatMemberUpdate
+	} else if u.ChatMember != nil {
+		return ChatMemberUpdate
+	} else if u.ChatJoinRequest != nil {
+		return ChatJoinRequestUpdate
+	} else if u.MessageReaction != nil {
+		return MessageReactionUpdate
+	} else if u.MessageReactionCount != nil {
+		return MessageReactionCountUpdate
+	} else if u.CallbackQuery != nil {
+		return CallbackQueryUpdate
+	} else if u.Message.ID != 0 {
+		return MessageUpdate
+	}
+
+	return UnknownUpdate
+}
+
+// AnyMessage returns the message contained in the update, whether it is a new or edited message or channel post.
+// It returns nil for updates that do not contain a message.
+func (u *Update) AnyMessage() *Message {
+	switch u.Kind() {
+	case MessageUpdate:
+		return &u.Message
+	case EditedMessageUpdate:
+		return u.EditedMessage
+	case ChannelPostUpdate:
+		return u.ChannelPost
+	case EditedChannelPostUpdate:
+		return u.EditedChannelPost
+	}
+	return nil
+}
+
+// Chat returns the chat the update happened in, regardless of its kind.
+// It returns nil for updates that are not related to a chat.
+func (u *Update) Chat() *Chat {
+	if m := u.AnyMessage(); m != nil {
+		return &m.Chat
+	}
+
+	switch u.Kind() {
+	case MyChatMemberUpdate:
+		return &u.MyChatMember.Chat
+	case ChatMemberUpdate:
+		return &u.ChatMember.Chat
+	case ChatJoinRequestUpdate:
+		return &u.ChatJoinRequest.Chat
+	case MessageReactionUpdate:
+		return &u.MessageReaction.Chat
+	case MessageReactionCountUpdate:
+		return &u.MessageReactionCount.Chat
+	case PollAnswerUpdate:
+		return u.PollAnswer.VoterChat
+	case CallbackQueryUpdate:
+		if u.CallbackQuery.Message != nil {
+			return &u.CallbackQuery.Message.Chat
+		}
+	}
+	return nil
+}
+
+// Sender returns the user that caused the update, regardless of its kind.
+// It returns nil for updates without a sender, for example channel posts.
+func (u *Update) Sender() *User {
+	if m := u.AnyMessage(); m != nil {
+		if m.From.ID == 0 {
+			return nil
+		}
+		return &m.From
+	}
+
+	switch u.Kind() {
+	case MyChatMemberUpdate:
+		return &u.MyChatMember.From
+	case ChatMemberUpdate:
+		return &u.ChatMember.From
+	case ChatJoinRequestUpdate:
+		return &u.ChatJoinRequest.From
+	case PollAnswerUpdate:
+		return u.PollAnswer.User
+	case ShippingQueryUpdate:
+		return &u.ShippingQuery.From
+	case PreCheckoutQueryUpdate:
+		return &u.PreCheckoutQuery.From
+	case CallbackQueryUpdate:
+		return &u.CallbackQuery.From
+	case MessageReactionUpdate:
+		return u.MessageReaction.User
+	}
+	return nil
 }
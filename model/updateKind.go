@@ -0,0 +1,61 @@
+package model
+
+// UpdateKind is the kind of an update
+type UpdateKind int
+
+// Update kinds
+const (
+	MessageUpdate              UpdateKind = iota // new messages
+	EditedMessageUpdate                          // edited messages
+	ChannelPostUpdate                            // new channel posts
+	EditedChannelPostUpdate                      // edited channel posts
+	PollUpdate                                   // poll state changes
+	PollAnswerUpdate                             // answers in non-anonymous polls
+	ShippingQueryUpdate                          // shipping queries
+	PreCheckoutQueryUpdate                       // pre-checkout queries
+	MyChatMemberUpdate                           // changes of the bot's chat member status
+	ChatMemberUpdate                             // changes of chat member statuses
+	ChatJoinRequestUpdate                        // join requests
+	MessageReactionUpdate                        // changes of reactions of users, only if explicitly allowed
+	MessageReactionCountUpdate                   // changes of anonymous reactions, only if explicitly allowed
+	CallbackQueryUpdate                          // callback queries from inline keyboard buttons
+
+	UnknownUpdate // unknown (probably new due to API changes)
+)
+
+var updateKinds = map[UpdateKind]string{
+	MessageUpdate:              "message",
+	EditedMessageUpdate:        "edited_message",
+	ChannelPostUpdate:          "channel_post",
+	EditedChannelPostUpdate:    "edited_channel_post",
+	PollUpdate:                 "poll",
+	PollAnswerUpdate:           "poll_answer",
+	ShippingQueryUpdate:        "shipping_query",
+	PreCheckoutQueryUpdate:     "pre_checkout_query",
+	MyChatMemberUpdate:         "my_chat_member",
+	ChatMemberUpdate:           "chat_member",
+	ChatJoinRequestUpdate:      "chat_join_request",
+	MessageReactionUpdate:      "message_reaction",
+	MessageReactionCountUpdate: "message_reaction_count",
+	CallbackQueryUpdate:        "callback_query",
+
+	UnknownUpdate: "UNKNOWN",
+}
+
+// AllUpdateKinds returns all known update kinds, including those that must be explicitly allowed
+func AllUpdateKinds() []UpdateKind {
+	toReturn := make([]UpdateKind, 0, UnknownUpdate)
+	for kind := MessageUpdate; kind < UnknownUpdate; kind++ {
+		toReturn = append(toReturn, kind)
+	}
+	return toReturn
+}
+
+// String returns the name of the update kind as used by the API, for example in allowed_updates
+func (uk UpdateKind) String() string {
+	val, ok := updateKinds[uk]
+	if !ok {
+		return updateKinds[UnknownUpdate]
+	}
+	return val
+}
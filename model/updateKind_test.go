@@ -0,0 +1,28 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestAllUpdateKinds(t *testing.T) {
+	kinds := map[string]bool{}
+	for _, kind := range AllUpdateKinds() {
+		if kind == UnknownUpdate || kind.String() == UnknownUpdate.String() {
+			t.Errorf("got unknown update kind %d", kind)
+		}
+		kinds[kind.String()] = true
+	}
+
+	for _, name := range []string{"message", "chat_member", "message_reaction", "message_reaction_count", "callback_query"} {
+		if !kinds[name] {
+			t.Errorf("%s is missing", name)
+		}
+	}
+	if len(kinds) != int(UnknownUpdate) {
+		t.Errorf("got %d distinct kinds, want %d", len(kinds), UnknownUpdate)
+	}
+
+	if name := UpdateKind(-1).String(); name != "UNKNOWN" {
+		t.Errorf("got name %s for an invalid kind", name)
+	}
+}
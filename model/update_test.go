@@ -0,0 +1,56 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateAccessors(t *testing.T) {
+	const (
+		message     = `{"message_id": 1, "date": 1700000000, "chat": {"id": 10, "type": "private"}, "from": {"id": 20, "is_bot": false, "first_name": "A"}, "text": "hi"}`
+		channelPost = `{"message_id": 1, "date": 1700000000, "chat": {"id": -10, "type": "channel"}, "text": "hi"}`
+		memberEvent = `{"chat": {"id": -30, "type": "supergroup"}, "from": {"id": 40, "is_bot": false, "first_name": "B"}, "date": 1700000000,
+			"old_chat_member": {"status": "left", "user": {"id": 40, "is_bot": false, "first_name": "B"}},
+			"new_chat_member": {"status": "member", "user": {"id": 40, "is_bot": false, "first_name": "B"}}}`
+	)
+
+	tests := []struct {
+		name       string
+		data       string
+		wantKind   UpdateKind
+		wantChat   int64 // 0 if there is no chat
+		wantSender int64 // 0 if there is no sender
+	}{
+		{"message", `"message": ` + message, MessageUpdate, 10, 20},
+		{"edited message", `"edited_message": ` + message, EditedMessageUpdate, 10, 20},
+		{"channel post", `"channel_post": ` + channelPost, ChannelPostUpdate, -10, 0},
+		{"edited channel post", `"edited_channel_post": ` + channelPost, EditedChannelPostUpdate, -10, 0},
+		{"my chat member", `"my_chat_member": ` + memberEvent, MyChatMemberUpdate, -30, 40},
+		{"chat member", `"chat_member": ` + memberEvent, ChatMemberUpdate, -30, 40},
+		{"chat join request", `"chat_join_request": {"chat": {"id": -50, "type": "supergroup"},
+			"from": {"id": 60, "is_bot": false, "first_name": "C"}, "user_chat_id": 60, "date": 1700000000}`,
+			ChatJoinRequestUpdate, -50, 60},
+		{"inline callback query", `"callback_query": {"id": "q", "from": {"id": 70, "is_bot": false, "first_name": "D"},
+			"inline_message_id": "m", "chat_instance": "i", "data": "d"}`, CallbackQueryUpdate, 0, 70},
+		{"poll", `"poll": {"id": "p", "question": "?", "options": [], "total_voter_count": 0, "is_closed": true,
+			"is_anonymous": true, "type": "regular", "allows_multiple_answers": false}`, PollUpdate, 0, 0},
+		{"unknown", `"new_update_kind": {}`, UnknownUpdate, 0, 0},
+	}
+
+	for _, tt := range tests {
+		var u Update
+		if err := json.Unmarshal([]byte(`{"update_id": 1, `+tt.data+`}`), &u); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		if kind := u.Kind(); kind != tt.wantKind {
+			t.Errorf("%s: got kind %s, want %s", tt.name, kind, tt.wantKind)
+		}
+		if chat := u.Chat(); chat == nil && tt.wantChat != 0 || chat != nil && chat.ID != tt.wantChat {
+			t.Errorf("%s: got chat %+v, want %d", tt.name, chat, tt.wantChat)
+		}
+		if sender := u.Sender(); sender == nil && tt.wantSender != 0 || sender != nil && sender.ID != tt.wantSender {
+			t.Errorf("%s: got sender %+v, want %d", tt.name, sender, tt.wantSender)
+		}
+	}
+}
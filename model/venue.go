@@ -0,0 +1,12 @@
+package model
+
+// Venue represents a venue
+type Venue struct {
+	Location        Location `json:"location"`          // location of the venue
+	Title           string   `json:"title"`             // name of the venue
+	Address         string   `json:"address"`           // address of the venue
+	FoursquareID    *string  `json:"foursquare_id"`     // foursquare identifier of the venue
+	FoursquareType  *string  `json:"foursquare_type"`   // foursquare type of the venue
+	GooglePlaceID   *string  `json:"google_place_id"`   // Google Places identifier of the venue
+	GooglePlaceType *string  `json:"google_place_type"` // Google Places type of the venue
+}
@@ -6,7 +6,7 @@ type Video struct {
 	Width     int       `json:"width"`
 	Height    int       `json:"height"`
 	Duration  int       `json:"duration"`
-	Thumbnail PhotoSize `json:"thumb"`
+	Thumbnail PhotoSize `json:"thumbnail"`
 	MimeType  string    `json:"mime_type"`
 	Caption   string    `json:"caption"`
 }
@@ -0,0 +1,87 @@
+package model
+
+// WebAppInfo describes a Web App
+type WebAppInfo struct {
+	URL string `json:"url"` // HTTPS URL of the Web App
+}
+
+// WebAppData represents data sent from a Web App to the bot, launched from a KeyboardButton
+type WebAppData struct {
+	Data       string `json:"data"`        // the data, which can be manipulated by the user
+	ButtonText string `json:"button_text"` // the text on the button the Web App was opened with
+}
+
+// SentWebAppMessageResponse represents the response sent by the API on an AnswerWebAppQuery request
+type SentWebAppMessageResponse struct {
+	BaseResponse
+	SentWebAppMessage SentWebAppMessage `json:"result"`
+}
+
+// SentWebAppMessage describes a message sent on behalf of a user via a Web App
+type SentWebAppMessage struct {
+	InlineMessageID *string `json:"inline_message_id"` // the sent inline message, if there is an inline keyboard attached
+}
+
+// InlineQueryResult is a marker interface for results of inline and Web App queries
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
+
+func (InlineQueryResultArticle) inlineQueryResult() {}
+
+// InlineQueryResultArticle represents a result that sends a text message
+type InlineQueryResultArticle struct {
+	Type                string                  `json:"type"`
+	ID                  string                  `json:"id"`
+	Title               string                  `json:"title"`
+	InputMessageContent InputTextMessageContent `json:"input_message_content"`
+	ReplyMarkup         *InlineKeyboardMarkup   `json:"reply_markup,omitempty"`
+	URL                 string                  `json:"url,omitempty"`
+	Description         string                  `json:"description,omitempty"`
+}
+
+// InputTextMessageContent represents the contents of a text message sent as the result of a query
+type InputTextMessageContent struct {
+	MessageText        string              `json:"message_text"`
+	ParseMode          ParseMode           `json:"parse_mode,omitempty"`
+	Entities           []MessageEntity     `json:"entities,omitempty"`
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+// NewInlineQueryResultArticle creates a new result sending a text message, id must be unique among the results
+func NewInlineQueryResultArticle(id, title, text string) *InlineQueryResultArticle {
+	return &InlineQueryResultArticle{
+		Type:  "article",
+		ID:    id,
+		Title: title,
+		InputMessageContent: InputTextMessageContent{
+			MessageText: text,
+		},
+	}
+}
+
+// SetParseMode sets the ParseMode for the text of the message (optional)
+func (ia *InlineQueryResultArticle) SetParseMode(to ParseMode) *InlineQueryResultArticle {
+	ia.InputMessageContent.ParseMode = to
+	return ia
+}
+
+// SetFormattedText sets the text of the message to the formatted text.
+// The formatting is sent as entities, so the ParseMode is reset.
+func (ia *InlineQueryResultArticle) SetFormattedText(to *FormattedText) *InlineQueryResultArticle {
+	ia.InputMessageContent.MessageText, ia.InputMessageContent.Entities = to.Entities()
+	ia.InputMessageContent.ParseMode = ModeDefault
+	return ia
+}
+
+// SetInlineKeyboardMarkup sets the inline keyboard attached to the message (optional)
+func (ia *InlineQueryResultArticle) SetInlineKeyboardMarkup(to InlineKeyboardMarkup) *InlineQueryResultArticle {
+	ia.ReplyMarkup = &to
+	return ia
+}
+
+// SetDescription sets a short description of the result (optional)
+func (ia *InlineQueryResultArticle) SetDescription(to string) *InlineQueryResultArticle {
+	ia.Description = to
+	return ia
+}
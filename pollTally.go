@@ -0,0 +1,87 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"sync"
+)
+
+// A PollTally keeps live tallies of non-anonymous polls from incoming poll answers.
+// It is safe for concurrent use.
+type PollTally struct {
+	polls map[string]map[int64][]int // poll ID -> voter ID -> chosen options
+	mu    sync.RWMutex
+}
+
+// NewPollTally creates a new, empty PollTally
+func NewPollTally() *PollTally {
+	return &PollTally{
+		polls: map[string]map[int64][]int{},
+	}
+}
+
+// Add records a poll answer, replacing any previous answer of the same voter.
+// Answers without options retract the vote of the voter.
+func (t *PollTally) Add(answer model.PollAnswer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	votes, ok := t.polls[answer.PollID]
+	if !ok {
+		votes = map[int64][]int{}
+		t.polls[answer.PollID] = votes
+	}
+
+	if len(answer.OptionIDs) == 0 {
+		delete(votes, answer.VoterID())
+		return
+	}
+	votes[answer.VoterID()] = append([]int(nil), answer.OptionIDs...)
+}
+
+// AddUpdate records the poll answer contained in the update, if any.
+// It returns whether the update contained a poll answer.
+func (t *PollTally) AddUpdate(update *model.Update) bool {
+	if update.PollAnswer == nil {
+		return false
+	}
+	t.Add(*update.PollAnswer)
+	return true
+}
+
+// Counts returns the number of votes for each option of the poll, by option index
+func (t *PollTally) Counts(pollID string) map[int]int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	toReturn := map[int]int{}
+	for _, options := range t.polls[pollID] {
+		for _, option := range options {
+			toReturn[option]++
+		}
+	}
+	return toReturn
+}
+
+// Voters returns the number of voters of the poll
+func (t *PollTally) Voters(pollID string) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return len(t.polls[pollID])
+}
+
+// Votes returns the options chosen by a voter in the poll, or nil if the voter did not vote
+func (t *PollTally) Votes(pollID string, voterID int64) []int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]int(nil), t.polls[pollID][voterID]...)
+}
+
+// Forget removes all recorded answers of the poll
+func (t *PollTally) Forget(pollID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.polls, pollID)
+}
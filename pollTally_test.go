@@ -0,0 +1,109 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"reflect"
+	"testing"
+)
+
+func TestPollTally(t *testing.T) {
+	alice, bob := &model.User{ID: 1}, &model.User{ID: 8589934592}
+	channel := &model.Chat{ID: -1001234567890123}
+	answer := func(user *model.User, chat *model.Chat, options ...int) *model.Update {
+		return &model.Update{PollAnswer: &model.PollAnswer{PollID: "p", User: user, VoterChat: chat, OptionIDs: options}}
+	}
+
+	tests := []struct {
+		name   string
+		update *model.Update
+		counts map[int]int
+		voters int
+	}{
+		{"vote", answer(alice, nil, 0), map[int]int{0: 1}, 1},
+		{"another vote", answer(bob, nil, 0, 2), map[int]int{0: 2, 2: 1}, 2},
+		{"anonymous vote", answer(nil, channel, 1), map[int]int{0: 2, 1: 1, 2: 1}, 3},
+		{"revote", answer(alice, nil, 1), map[int]int{0: 1, 1: 2, 2: 1}, 3},
+		{"retract", answer(bob, nil), map[int]int{1: 2}, 2},
+		{"retract without vote", answer(bob, nil), map[int]int{1: 2}, 2},
+	}
+
+	tally := NewPollTally()
+	for _, tt := range tests {
+		if !tally.AddUpdate(tt.update) {
+			t.Fatalf("%s: update without poll answer", tt.name)
+		}
+		if got := tally.Counts("p"); !reflect.DeepEqual(got, tt.counts) {
+			t.Fatalf("%s: got counts %v, want %v", tt.name, got, tt.counts)
+		}
+		if got := tally.Voters("p"); got != tt.voters {
+			t.Fatalf("%s: got %d voters, want %d", tt.name, got, tt.voters)
+		}
+	}
+
+	if got := tally.Votes("p", alice.ID); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("got votes %v for alice", got)
+	}
+	if got := tally.Votes("p", bob.ID); got != nil {
+		t.Errorf("got votes %v for bob after retracting", got)
+	}
+	if tally.AddUpdate(&model.Update{}) {
+		t.Error("update without poll answer was recorded")
+	}
+
+	tally.Forget("p")
+	if tally.Voters("p") != 0 {
+		t.Error("poll was not forgotten")
+	}
+}
+
+func TestSendQuiz(t *testing.T) {
+	f := newFakeBot(t)
+	f.handle("sendPoll", func(params map[string]interface{}) interface{} {
+		return map[string]interface{}{"message_id": 3, "date": 1700000000,
+			"chat": map[string]interface{}{"id": fakeChatID, "type": "private"},
+			"poll": map[string]interface{}{"id": "p", "question": params["question"], "options": []interface{}{},
+				"total_voter_count": 0, "is_closed": false, "is_anonymous": params["is_anonymous"], "type": params["type"],
+				"allows_multiple_answers": false}}
+	})
+	f.handle("stopPoll", func(params map[string]interface{}) interface{} {
+		return map[string]interface{}{"id": "p", "question": "?", "options": []interface{}{}, "total_voter_count": 2,
+			"is_closed": true, "is_anonymous": false, "type": "quiz", "allows_multiple_answers": false}
+	})
+	api := f.connect()
+
+	quiz := model.NewOutgoingQuiz(model.NewChatRecipient(fakeChatID), "2+2?", 1, "3", "4", "5").
+		SetAnonymous(false).SetExplanation("*basic* math", model.ModeMarkdownV2).SetOpenPeriod(30)
+	resp, err := api.SendPoll(quiz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Message.Type() != model.PollType || resp.Message.Poll.Type != model.PollQuiz {
+		t.Errorf("unexpected message %+v", resp.Message)
+	}
+
+	params := f.callsTo("sendPoll")[0].Params
+	want := map[string]interface{}{"type": "quiz", "is_anonymous": false, "explanation": "*basic* math",
+		"explanation_parse_mode": "MarkdownV2"}
+	for key, value := range want {
+		if params[key] != value {
+			t.Errorf("got %s %v, want %v", key, params[key], value)
+		}
+	}
+	if fakeInt(params, "correct_option_id") != 1 || fakeInt(params, "open_period") != 30 {
+		t.Errorf("got correct_option_id %v and open_period %v", params["correct_option_id"], params["open_period"])
+	}
+	if _, ok := params["close_date"]; ok {
+		t.Errorf("close_date was sent with open_period")
+	}
+	if options, _ := params["options"].([]interface{}); len(options) != 3 {
+		t.Errorf("got options %v", params["options"])
+	}
+
+	poll, err := api.StopPoll(model.NewChatRecipient(fakeChatID), resp.Message.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !poll.Poll.IsClosed || fakeInt(f.callsTo("stopPoll")[0].Params, "message_id") != 3 {
+		t.Errorf("unexpected stopped poll %+v", poll.Poll)
+	}
+}
@@ -0,0 +1,98 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"reflect"
+)
+
+// A BotProfile contains the texts of the bots profile for one language, and optionally the settings shared by all
+// languages.
+// The settings shared by all languages are left unchanged if nil, so they only need to be given in one profile.
+type BotProfile struct {
+	LanguageCode               string                         // the language of the texts, empty for all users without a dedicated profile
+	Name                       string                         // the name of the bot, 0-64 characters
+	Description                string                         // the description of the bot shown in empty chats, 0-512 characters
+	ShortDescription           string                         // the short description of the bot shown on its profile page, 0-120 characters
+	MenuButton                 *model.MenuButton              // the default menu button in private chats, shared by all languages
+	GroupAdministratorRights   *model.ChatAdministratorRights // the rights requested by default in groups, shared by all languages
+	ChannelAdministratorRights *model.ChatAdministratorRights // the rights requested by default in channels, shared by all languages
+}
+
+// SyncMyProfile makes sure the bots name, description and short description for the language of the profile are the
+// ones given. Empty texts are removed. The default menu button and default administrator rights are synced as well,
+// if set in the profile.
+// The current texts are retrieved first, and only updated if they differ, which makes this suitable for calling at
+// every startup. Changing the name is heavily rate limited by Telegram.
+// It returns whether the profile was changed.
+func (api *TelegramBotAPI) SyncMyProfile(p BotProfile) (bool, error) {
+	changed := false
+
+	name, err := api.GetMyName(p.LanguageCode)
+	if err != nil {
+		return false, err
+	}
+	if name.BotName.Name != p.Name {
+		if _, err = api.SetMyName(p.Name, p.LanguageCode); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	description, err := api.GetMyDescription(p.LanguageCode)
+	if err != nil {
+		return changed, err
+	}
+	if description.BotDescription.Description != p.Description {
+		if _, err = api.SetMyDescription(p.Description, p.LanguageCode); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	shortDescription, err := api.GetMyShortDescription(p.LanguageCode)
+	if err != nil {
+		return changed, err
+	}
+	if shortDescription.BotShortDescription.ShortDescription != p.ShortDescription {
+		if _, err = api.SetMyShortDescription(p.ShortDescription, p.LanguageCode); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	if p.MenuButton != nil {
+		button, err := api.GetChatMenuButton(0)
+		if err != nil {
+			return changed, err
+		}
+		if !reflect.DeepEqual(button.MenuButton, *p.MenuButton) {
+			if _, err = api.SetChatMenuButton(0, p.MenuButton); err != nil {
+				return changed, err
+			}
+			changed = true
+		}
+	}
+
+	for _, forChannels := range []bool{false, true} {
+		rights := p.GroupAdministratorRights
+		if forChannels {
+			rights = p.ChannelAdministratorRights
+		}
+		if rights == nil {
+			continue
+		}
+
+		current, err := api.GetMyDefaultAdministratorRights(forChannels)
+		if err != nil {
+			return changed, err
+		}
+		if current.Rights != *rights {
+			if _, err = api.SetMyDefaultAdministratorRights(rights, forChannels); err != nil {
+				return changed, err
+			}
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
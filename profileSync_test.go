@@ -0,0 +1,163 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeProfile serves the profile methods of a fakeBot from its fields
+type fakeProfile struct {
+	mu            sync.Mutex
+	texts         map[string]string // by method suffix and language, for example "Name/en"
+	menuButton    map[string]interface{}
+	groupRights   map[string]interface{}
+	channelRights map[string]interface{}
+}
+
+func newFakeProfile(f *fakeBot) *fakeProfile {
+	p := &fakeProfile{
+		texts:         map[string]string{"Name/": "Bot", "Description/": "A bot", "ShortDescription/": "Bot"},
+		menuButton:    map[string]interface{}{"type": "default"},
+		groupRights:   map[string]interface{}{},
+		channelRights: map[string]interface{}{},
+	}
+
+	for method, field := range map[string]string{"Name": "name", "Description": "description", "ShortDescription": "short_description"} {
+		method, field := method, field
+		f.handle("getMy"+method, func(params map[string]interface{}) interface{} {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			return map[string]interface{}{field: p.texts[method+"/"+fakeString(params, "language_code")]}
+		})
+		f.handle("setMy"+method, func(params map[string]interface{}) interface{} {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.texts[method+"/"+fakeString(params, "language_code")] = fakeString(params, field)
+			return true
+		})
+	}
+
+	f.handle("getChatMenuButton", func(map[string]interface{}) interface{} {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.menuButton
+	})
+	f.handle("setChatMenuButton", func(params map[string]interface{}) interface{} {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.menuButton = params["menu_button"].(map[string]interface{})
+		return true
+	})
+	f.handle("getMyDefaultAdministratorRights", func(params map[string]interface{}) interface{} {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if params["for_channels"] == true {
+			return p.channelRights
+		}
+		return p.groupRights
+	})
+	f.handle("setMyDefaultAdministratorRights", func(params map[string]interface{}) interface{} {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if params["for_channels"] == true {
+			p.channelRights = params["rights"].(map[string]interface{})
+		} else {
+			p.groupRights = params["rights"].(map[string]interface{})
+		}
+		return true
+	})
+
+	return p
+}
+
+// fakeString returns the string parameter, or "" if it is not set
+func fakeString(params map[string]interface{}, key string) string {
+	s, _ := params[key].(string)
+	return s
+}
+
+// setCalls returns the number of calls to methods changing the profile
+func setCalls(f *fakeBot) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := 0
+	for _, c := range f.calls {
+		if strings.HasPrefix(strings.ToLower(c.Method), "set") {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSyncMyProfileUnchanged(t *testing.T) {
+	f := newFakeBot(t)
+	newFakeProfile(f)
+	api := f.connect()
+
+	changed, err := api.SyncMyProfile(BotProfile{
+		Name:                       "Bot",
+		Description:                "A bot",
+		ShortDescription:           "Bot",
+		MenuButton:                 model.NewDefaultMenuButton(),
+		GroupAdministratorRights:   &model.ChatAdministratorRights{},
+		ChannelAdministratorRights: &model.ChatAdministratorRights{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("unchanged profile reported as changed")
+	}
+	if n := setCalls(f); n != 0 {
+		t.Errorf("unchanged profile made %d Set calls", n)
+	}
+}
+
+func TestSyncMyProfile(t *testing.T) {
+	f := newFakeBot(t)
+	p := newFakeProfile(f)
+	api := f.connect()
+
+	profile := BotProfile{
+		LanguageCode:             "de",
+		Name:                     "Bot",
+		Description:              "Ein Bot",
+		MenuButton:               model.NewWebAppMenuButton("Open", "https://example.com/app"),
+		GroupAdministratorRights: &model.ChatAdministratorRights{CanDeleteMessages: true, CanPinMessages: true},
+	}
+
+	changed, err := api.SyncMyProfile(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Error("changed profile reported as unchanged")
+	}
+	// the short description is already empty for German, channel rights are not given
+	for _, method := range []string{"setMyName", "setMyDescription", "setChatMenuButton", "setMyDefaultAdministratorRights"} {
+		if n := len(f.callsTo(method)); n != 1 {
+			t.Errorf("%s was called %d times", method, n)
+		}
+	}
+	if n := setCalls(f); n != 4 {
+		t.Errorf("got %d Set calls, want 4", n)
+	}
+	if p.texts["Name/de"] != "Bot" || p.texts["Description/de"] != "Ein Bot" || p.texts["Name/"] != "Bot" {
+		t.Errorf("unexpected texts %v", p.texts)
+	}
+	if p.menuButton["type"] != "web_app" || p.groupRights["can_pin_messages"] != true {
+		t.Errorf("unexpected menu button %v and rights %v", p.menuButton, p.groupRights)
+	}
+
+	// syncing again finds the profile unchanged
+	changed, err = api.SyncMyProfile(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed || setCalls(f) != 4 {
+		t.Errorf("second sync changed the profile")
+	}
+}
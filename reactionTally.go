@@ -0,0 +1,134 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"sync"
+)
+
+// reactedMessage identifies a message reactions are tallied for
+type reactedMessage struct {
+	chatID    int64
+	messageID int
+}
+
+// reactionCounts are the reactions tallied for a message
+type reactionCounts struct {
+	users     map[model.ReactionType]int // sum of the changes by users
+	anonymous map[model.ReactionType]int // last counts of anonymous reactions
+}
+
+// A ReactionTally keeps live counts of the reactions on messages from incoming reaction updates.
+// Both MessageReactionUpdate and MessageReactionCountUpdate must be allowed using SetAllowedUpdates.
+// Changes by users are applied as they arrive, counts of anonymous reactions replace the previous anonymous counts of
+// the message. The tally of a message is the sum of both.
+// It is safe for concurrent use.
+type ReactionTally struct {
+	messages map[reactedMessage]*reactionCounts
+	mu       sync.RWMutex
+}
+
+// NewReactionTally creates a new, empty ReactionTally
+func NewReactionTally() *ReactionTally {
+	return &ReactionTally{
+		messages: map[reactedMessage]*reactionCounts{},
+	}
+}
+
+// counts returns the tally of the message, creating it if necessary.
+// It expects t.mu to be held.
+func (t *ReactionTally) counts(key reactedMessage) *reactionCounts {
+	counts, ok := t.messages[key]
+	if !ok {
+		counts = &reactionCounts{
+			users:     map[model.ReactionType]int{},
+			anonymous: map[model.ReactionType]int{},
+		}
+		t.messages[key] = counts
+	}
+	return counts
+}
+
+// Add records a change of the reactions of a user.
+// Removed reactions that were not added by a user since the tally was started are ignored, because they were added
+// before the tally was started. They are never subtracted from the counts of anonymous reactions.
+func (t *ReactionTally) Add(change model.MessageReactionUpdated) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	users := t.counts(reactedMessage{chatID: change.Chat.ID, messageID: change.MessageID}).users
+	for _, reaction := range change.OldReaction {
+		if users[reaction] <= 1 {
+			delete(users, reaction)
+			continue
+		}
+		users[reaction]--
+	}
+	for _, reaction := range change.NewReaction {
+		users[reaction]++
+	}
+}
+
+// AddCount records the counts of the anonymous reactions on a message, replacing its previous anonymous counts
+func (t *ReactionTally) AddCount(count model.MessageReactionCountUpdated) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	anonymous := map[model.ReactionType]int{}
+	for _, reaction := range count.Reactions {
+		anonymous[reaction.Type] = reaction.TotalCount
+	}
+	t.counts(reactedMessage{chatID: count.Chat.ID, messageID: count.MessageID}).anonymous = anonymous
+}
+
+// AddUpdate records the reaction change or reaction counts contained in the update, if any.
+// It returns whether the update contained reactions.
+func (t *ReactionTally) AddUpdate(update *model.Update) bool {
+	switch {
+	case update.MessageReaction != nil:
+		t.Add(*update.MessageReaction)
+	case update.MessageReactionCount != nil:
+		t.AddCount(*update.MessageReactionCount)
+	default:
+		return false
+	}
+	return true
+}
+
+// Counts returns the number of times each reaction was added to the message
+func (t *ReactionTally) Counts(chatID int64, messageID int) map[model.ReactionType]int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	toReturn := map[model.ReactionType]int{}
+	counts, ok := t.messages[reactedMessage{chatID: chatID, messageID: messageID}]
+	if !ok {
+		return toReturn
+	}
+	for reaction, count := range counts.users {
+		toReturn[reaction] += count
+	}
+	for reaction, count := range counts.anonymous {
+		toReturn[reaction] += count
+	}
+	return toReturn
+}
+
+// Count returns the number of times the reaction was added to the message
+func (t *ReactionTally) Count(chatID int64, messageID int, reaction model.ReactionType) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	counts, ok := t.messages[reactedMessage{chatID: chatID, messageID: messageID}]
+	if !ok {
+		return 0
+	}
+	return counts.users[reaction] + counts.anonymous[reaction]
+}
+
+// Forget removes the tally of the message
+func (t *ReactionTally) Forget(chatID int64, messageID int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.messages, reactedMessage{chatID: chatID, messageID: messageID})
+}
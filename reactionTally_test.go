@@ -0,0 +1,73 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"reflect"
+	"testing"
+)
+
+func TestReactionTally(t *testing.T) {
+	chat := model.Chat{ID: -1001234567890123}
+	like, love, fire := model.NewEmojiReaction("👍"), model.NewEmojiReaction("❤"), model.NewEmojiReaction("🔥")
+	change := func(old, new []model.ReactionType) model.MessageReactionUpdated {
+		return model.MessageReactionUpdated{Chat: chat, MessageID: 1, OldReaction: old, NewReaction: new}
+	}
+
+	tests := []struct {
+		name   string
+		update model.Update
+		want   map[model.ReactionType]int
+	}{
+		{"add", model.Update{MessageReaction: ptr(change(nil, []model.ReactionType{like}))},
+			map[model.ReactionType]int{like: 1}},
+		{"add by another user", model.Update{MessageReaction: ptr(change(nil, []model.ReactionType{like, love}))},
+			map[model.ReactionType]int{like: 2, love: 1}},
+		{"change", model.Update{MessageReaction: ptr(change([]model.ReactionType{like}, []model.ReactionType{fire}))},
+			map[model.ReactionType]int{like: 1, love: 1, fire: 1}},
+		{"remove", model.Update{MessageReaction: ptr(change([]model.ReactionType{like, love}, nil))},
+			map[model.ReactionType]int{fire: 1}},
+		{"remove never seen", model.Update{MessageReaction: ptr(change([]model.ReactionType{love}, nil))},
+			map[model.ReactionType]int{fire: 1}},
+		{"counts", model.Update{MessageReactionCount: &model.MessageReactionCountUpdated{Chat: chat, MessageID: 1,
+			Reactions: []model.ReactionCount{{Type: like, TotalCount: 3}, {Type: love, TotalCount: 1}}}},
+			map[model.ReactionType]int{like: 3, love: 1, fire: 1}},
+		{"add after counts", model.Update{MessageReaction: ptr(change(nil, []model.ReactionType{like}))},
+			map[model.ReactionType]int{like: 4, love: 1, fire: 1}},
+		{"counts after add", model.Update{MessageReactionCount: &model.MessageReactionCountUpdated{Chat: chat, MessageID: 1,
+			Reactions: []model.ReactionCount{{Type: like, TotalCount: 2}}}},
+			map[model.ReactionType]int{like: 3, fire: 1}},
+		{"remove after counts", model.Update{MessageReaction: ptr(change([]model.ReactionType{like, fire}, nil))},
+			map[model.ReactionType]int{like: 2}},
+		{"remove anonymous", model.Update{MessageReaction: ptr(change([]model.ReactionType{like}, nil))},
+			map[model.ReactionType]int{like: 2}},
+	}
+
+	tally := NewReactionTally()
+	for _, tt := range tests {
+		if !tally.AddUpdate(&tt.update) {
+			t.Fatalf("%s: update without reactions", tt.name)
+		}
+		if got := tally.Counts(chat.ID, 1); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if n := tally.Count(chat.ID, 1, like); n != 2 {
+		t.Errorf("got count %d, want 2", n)
+	}
+	if n := tally.Count(chat.ID, 2, like); n != 0 {
+		t.Errorf("got count %d for another message, want 0", n)
+	}
+	if tally.AddUpdate(&model.Update{}) {
+		t.Error("update without reactions was recorded")
+	}
+
+	tally.Forget(chat.ID, 1)
+	if got := tally.Counts(chat.ID, 1); len(got) != 0 {
+		t.Errorf("got %v after Forget", got)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
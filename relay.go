@@ -0,0 +1,74 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"errors"
+	"fmt"
+)
+
+// ResendMessage sends the contents of a received message to the recipient, without a link to the original message.
+// The method used to send is chosen based on the type of the message. Files are resent by their IDs, so no
+// access to the original chat is required, unlike with CopyMessage.
+// Captions are resent with their formatting, dice are thrown anew.
+// An error is returned for message types that cannot be resent, including all chat actions.
+func (api *TelegramBotAPI) ResendMessage(m *model.Message, recipient model.Recipient) (*model.MessageResponse, error) {
+	caption, captionEntities := "", m.Entities()
+	if m.Caption != nil {
+		caption = *m.Caption
+	}
+
+	switch m.Type() {
+	case model.TextType:
+		om := model.NewOutgoingMessage(recipient, *m.Text)
+		if m.TextEntities != nil {
+			om.Entities = *m.TextEntities
+		}
+		return api.SendMessageExtended(om)
+	case model.AudioType:
+		return api.ResendAudio(model.NewOutgoingAudio(recipient).SetCaption(caption).SetCaptionEntities(captionEntities), m.Audio.ID)
+	case model.DocumentType:
+		return api.ResendDocument(model.NewOutgoingDocument(recipient).SetCaption(caption).SetCaptionEntities(captionEntities), m.Document.ID)
+	case model.PhotoType:
+		photos := *m.Photo
+		if len(photos) == 0 {
+			return nil, errors.New("tbotapi: cannot resend photo without sizes")
+		}
+		return api.ResendPhoto(model.NewOutgoingPhoto(recipient).SetCaption(caption).SetCaptionEntities(captionEntities), photos[len(photos)-1].ID)
+	case model.StickerType:
+		return api.ResendSticker(model.NewOutgoingSticker(recipient), m.Sticker.ID)
+	case model.VideoType:
+		return api.ResendVideo(model.NewOutgoingVideo(recipient).SetCaption(caption).SetCaptionEntities(captionEntities), m.Video.ID)
+	case model.VoiceType:
+		return api.ResendVoice(model.NewOutgoingVoice(recipient).SetCaption(caption).SetCaptionEntities(captionEntities), m.Voice.ID)
+	case model.ContactType:
+		oc := model.NewOutgoingContact(recipient, m.Contact.PhoneNumber, m.Contact.FirstName).SetLastName(m.Contact.LastName)
+		if m.Contact.VCard != nil {
+			oc.SetVCard(*m.Contact.VCard)
+		}
+		return api.SendContact(oc)
+	case model.LocationType:
+		return api.SendLocation(model.NewOutgoingLocation(recipient, m.Location.Latitude, m.Location.Longitude))
+	case model.VenueType:
+		v := m.Venue
+		ov := model.NewOutgoingVenue(recipient, v.Location.Latitude, v.Location.Longitude, v.Title, v.Address)
+		if v.FoursquareID != nil {
+			typ := ""
+			if v.FoursquareType != nil {
+				typ = *v.FoursquareType
+			}
+			ov.SetFoursquare(*v.FoursquareID, typ)
+		}
+		if v.GooglePlaceID != nil {
+			typ := ""
+			if v.GooglePlaceType != nil {
+				typ = *v.GooglePlaceType
+			}
+			ov.SetGooglePlace(*v.GooglePlaceID, typ)
+		}
+		return api.SendVenue(ov)
+	case model.DiceType:
+		return api.SendDice(model.NewOutgoingDice(recipient).SetEmoji(m.Dice.Emoji))
+	}
+
+	return nil, fmt.Errorf("tbotapi: cannot resend messages of type %s", m.Type())
+}
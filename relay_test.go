@@ -0,0 +1,56 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"encoding/json"
+	"testing"
+)
+
+func TestResendMessageCaptions(t *testing.T) {
+	f := newFakeBot(t)
+	for _, method := range []string{"sendAudio", "sendDocument", "sendPhoto", "sendVideo", "sendVoice"} {
+		f.handle(method, func(params map[string]interface{}) interface{} {
+			return map[string]interface{}{"message_id": 1, "date": 1700000000, "chat": map[string]interface{}{"id": fakeChatID, "type": "private"}}
+		})
+	}
+	api := f.connect()
+
+	media := []struct {
+		method string
+		field  string
+	}{
+		{"sendAudio", `"audio": {"file_id": "audio", "duration": 1}`},
+		{"sendDocument", `"document": {"file_id": "document"}`},
+		{"sendPhoto", `"photo": [{"file_id": "small", "width": 1, "height": 1}, {"file_id": "photo", "width": 2, "height": 2}]`},
+		{"sendVideo", `"video": {"file_id": "video", "width": 1, "height": 1, "duration": 1}`},
+		{"sendVoice", `"voice": {"file_id": "voice", "duration": 1}`},
+	}
+
+	for _, tt := range media {
+		data := `{"message_id": 1, "date": 1700000000, "chat": {"id": 1, "type": "private"}, ` + tt.field + `,
+			"caption": "bold text", "caption_entities": [{"type": "bold", "offset": 0, "length": 4}]}`
+		var m model.Message
+		if err := json.Unmarshal([]byte(data), &m); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := api.ResendMessage(&m, model.NewChatRecipient(fakeChatID)); err != nil {
+			t.Errorf("%s: %v", tt.method, err)
+			continue
+		}
+
+		calls := f.callsTo(tt.method)
+		if len(calls) != 1 {
+			t.Errorf("%s was called %d times", tt.method, len(calls))
+			continue
+		}
+		params := calls[0].Params
+		if params["caption"] != "bold text" {
+			t.Errorf("%s: got caption %v", tt.method, params["caption"])
+		}
+		entities, _ := params["caption_entities"].([]interface{})
+		if len(entities) != 1 || entities[0].(map[string]interface{})["type"] != "bold" {
+			t.Errorf("%s: got caption entities %v", tt.method, params["caption_entities"])
+		}
+	}
+}
@@ -10,32 +10,97 @@ import (
 type method string
 
 const (
-	getMe                = method("GetMe")
-	sendMessage          = method("SendMessage")
-	forwardMessage       = method("ForwardMessage")
-	sendPhoto            = method("SendPhoto")
-	sendAudio            = method("SendAudio")
-	sendDocument         = method("SendDocument")
-	sendSticker          = method("SendSticker")
-	sendVideo            = method("SendVideo")
-	sendVoice            = method("SendVoice")
-	sendLocation         = method("SendLocation")
-	sendChatAction       = method("SendChatAction")
-	getUserProfilePhotos = method("GetUserProfilePhotos")
-	getUpdates           = method("GetUpdates")
-	setWebhook           = method("SetWebhook")
-	getFile              = method("GetFile")
+	getMe                           = method("GetMe")
+	sendMessage                     = method("SendMessage")
+	forwardMessage                  = method("ForwardMessage")
+	sendPhoto                       = method("SendPhoto")
+	sendAudio                       = method("SendAudio")
+	sendDocument                    = method("SendDocument")
+	sendSticker                     = method("SendSticker")
+	sendVideo                       = method("SendVideo")
+	sendVoice                       = method("SendVoice")
+	sendLocation                    = method("SendLocation")
+	sendChatAction                  = method("SendChatAction")
+	getUserProfilePhotos            = method("GetUserProfilePhotos")
+	getUpdates                      = method("GetUpdates")
+	setWebhook                      = method("SetWebhook")
+	getFile                         = method("GetFile")
+	sendMediaGroup                  = method("SendMediaGroup")
+	sendContact                     = method("SendContact")
+	sendVenue                       = method("SendVenue")
+	sendDice                        = method("SendDice")
+	editMessageLiveLocation         = method("EditMessageLiveLocation")
+	stopMessageLiveLocation         = method("StopMessageLiveLocation")
+	sendPoll                        = method("SendPoll")
+	stopPoll                        = method("StopPoll")
+	sendInvoice                     = method("SendInvoice")
+	createInvoiceLink               = method("CreateInvoiceLink")
+	answerShippingQuery             = method("AnswerShippingQuery")
+	answerPreCheckoutQuery          = method("AnswerPreCheckoutQuery")
+	refundStarPayment               = method("RefundStarPayment")
+	getStarTransactions             = method("GetStarTransactions")
+	sendGame                        = method("SendGame")
+	setGameScore                    = method("SetGameScore")
+	getGameHighScores               = method("GetGameHighScores")
+	getStickerSet                   = method("GetStickerSet")
+	getCustomEmojiStickers          = method("GetCustomEmojiStickers")
+	uploadStickerFile               = method("UploadStickerFile")
+	createNewStickerSet             = method("CreateNewStickerSet")
+	addStickerToSet                 = method("AddStickerToSet")
+	setStickerPositionInSet         = method("SetStickerPositionInSet")
+	deleteStickerFromSet            = method("DeleteStickerFromSet")
+	setStickerSetThumbnail          = method("SetStickerSetThumbnail")
+	setMyCommands                   = method("SetMyCommands")
+	getMyCommands                   = method("GetMyCommands")
+	deleteMyCommands                = method("DeleteMyCommands")
+	exportChatInviteLink            = method("ExportChatInviteLink")
+	createChatInviteLink            = method("CreateChatInviteLink")
+	editChatInviteLink              = method("EditChatInviteLink")
+	revokeChatInviteLink            = method("RevokeChatInviteLink")
+	approveChatJoinRequest          = method("ApproveChatJoinRequest")
+	declineChatJoinRequest          = method("DeclineChatJoinRequest")
+	pinChatMessage                  = method("PinChatMessage")
+	unpinChatMessage                = method("UnpinChatMessage")
+	unpinAllChatMessages            = method("UnpinAllChatMessages")
+	setChatTitle                    = method("SetChatTitle")
+	setChatDescription              = method("SetChatDescription")
+	setChatPhoto                    = method("SetChatPhoto")
+	deleteChatPhoto                 = method("DeleteChatPhoto")
+	createForumTopic                = method("CreateForumTopic")
+	editForumTopic                  = method("EditForumTopic")
+	closeForumTopic                 = method("CloseForumTopic")
+	reopenForumTopic                = method("ReopenForumTopic")
+	deleteForumTopic                = method("DeleteForumTopic")
+	copyMessage                     = method("CopyMessage")
+	forwardMessages                 = method("ForwardMessages")
+	copyMessages                    = method("CopyMessages")
+	deleteMessages                  = method("DeleteMessages")
+	setMessageReaction              = method("SetMessageReaction")
+	setMyName                       = method("SetMyName")
+	getMyName                       = method("GetMyName")
+	setMyDescription                = method("SetMyDescription")
+	getMyDescription                = method("GetMyDescription")
+	setMyShortDescription           = method("SetMyShortDescription")
+	getMyShortDescription           = method("GetMyShortDescription")
+	setChatMenuButton               = method("SetChatMenuButton")
+	getChatMenuButton               = method("GetChatMenuButton")
+	setMyDefaultAdministratorRights = method("SetMyDefaultAdministratorRights")
+	getMyDefaultAdministratorRights = method("GetMyDefaultAdministratorRights")
+	answerWebAppQuery               = method("AnswerWebAppQuery")
+	answerCallbackQuery             = method("AnswerCallbackQuery")
 )
 
 type client struct {
 	c         *resty.Client
 	endpoints map[method]string
+	baseURI   string
 }
 
 func newClient(baseURI string) *client {
 	toReturn := &client{
 		c:         resty.New().SetHTTPMode().OnAfterResponse(parseResponseBody).OnAfterResponse(checkHTTPStatus),
 		endpoints: createEndpoints(baseURI),
+		baseURI:   baseURI,
 	}
 
 	return toReturn
@@ -57,6 +122,26 @@ func (c *client) uploadFile(m method, result interface{}, data file, fields enco
 	return c.c.R().SetFile(data.fieldName, data.path).SetResult(result).SetFormData(map[string]string(fields.GetQueryString())).Post(c.getEndpoint(m))
 }
 
+func (c *client) uploadFiles(m method, result interface{}, files []file, fields encodable) (*resty.Response, error) {
+	req := c.c.R()
+	for _, data := range files {
+		req.SetFile(data.fieldName, data.path)
+	}
+	return req.SetResult(result).SetFormData(map[string]string(fields.GetQueryString())).Post(c.getEndpoint(m))
+}
+
+func (c *client) postJSONRaw(methodName string, result interface{}, data interface{}) (*resty.Response, error) {
+	return c.c.R().SetBody(data).SetResult(result).Post(fmt.Sprint(c.baseURI, "/", methodName))
+}
+
+func (c *client) uploadFilesRaw(methodName string, result interface{}, files []file, fields encodable) (*resty.Response, error) {
+	req := c.c.R()
+	for _, data := range files {
+		req.SetFile(data.fieldName, data.path)
+	}
+	return req.SetResult(result).SetFormData(map[string]string(fields.GetQueryString())).Post(fmt.Sprint(c.baseURI, "/", methodName))
+}
+
 func parseResponseBody(c *resty.Client, res *resty.Response) (err error) {
 	// Handles only JSON
 	ct := res.Header().Get(http.CanonicalHeaderKey("Content-Type"))
@@ -105,6 +190,69 @@ func createEndpoints(baseURI string) map[method]string {
 	toReturn[getUpdates] = fmt.Sprint(baseURI, "/", string(getUpdates))
 	toReturn[setWebhook] = fmt.Sprint(baseURI, "/", string(setWebhook))
 	toReturn[getFile] = fmt.Sprint(baseURI, "/", string(getFile))
+	toReturn[sendMediaGroup] = fmt.Sprint(baseURI, "/", string(sendMediaGroup))
 
+	toReturn[sendContact] = fmt.Sprint(baseURI, "/", string(sendContact))
+	toReturn[sendVenue] = fmt.Sprint(baseURI, "/", string(sendVenue))
+	toReturn[sendDice] = fmt.Sprint(baseURI, "/", string(sendDice))
+	toReturn[editMessageLiveLocation] = fmt.Sprint(baseURI, "/", string(editMessageLiveLocation))
+	toReturn[stopMessageLiveLocation] = fmt.Sprint(baseURI, "/", string(stopMessageLiveLocation))
+	toReturn[sendPoll] = fmt.Sprint(baseURI, "/", string(sendPoll))
+	toReturn[stopPoll] = fmt.Sprint(baseURI, "/", string(stopPoll))
+	toReturn[sendInvoice] = fmt.Sprint(baseURI, "/", string(sendInvoice))
+	toReturn[createInvoiceLink] = fmt.Sprint(baseURI, "/", string(createInvoiceLink))
+	toReturn[answerShippingQuery] = fmt.Sprint(baseURI, "/", string(answerShippingQuery))
+	toReturn[answerPreCheckoutQuery] = fmt.Sprint(baseURI, "/", string(answerPreCheckoutQuery))
+	toReturn[refundStarPayment] = fmt.Sprint(baseURI, "/", string(refundStarPayment))
+	toReturn[getStarTransactions] = fmt.Sprint(baseURI, "/", string(getStarTransactions))
+	toReturn[sendGame] = fmt.Sprint(baseURI, "/", string(sendGame))
+	toReturn[setGameScore] = fmt.Sprint(baseURI, "/", string(setGameScore))
+	toReturn[getGameHighScores] = fmt.Sprint(baseURI, "/", string(getGameHighScores))
+	toReturn[getStickerSet] = fmt.Sprint(baseURI, "/", string(getStickerSet))
+	toReturn[getCustomEmojiStickers] = fmt.Sprint(baseURI, "/", string(getCustomEmojiStickers))
+	toReturn[uploadStickerFile] = fmt.Sprint(baseURI, "/", string(uploadStickerFile))
+	toReturn[createNewStickerSet] = fmt.Sprint(baseURI, "/", string(createNewStickerSet))
+	toReturn[addStickerToSet] = fmt.Sprint(baseURI, "/", string(addStickerToSet))
+	toReturn[setStickerPositionInSet] = fmt.Sprint(baseURI, "/", string(setStickerPositionInSet))
+	toReturn[deleteStickerFromSet] = fmt.Sprint(baseURI, "/", string(deleteStickerFromSet))
+	toReturn[setStickerSetThumbnail] = fmt.Sprint(baseURI, "/", string(setStickerSetThumbnail))
+	toReturn[setMyCommands] = fmt.Sprint(baseURI, "/", string(setMyCommands))
+	toReturn[getMyCommands] = fmt.Sprint(baseURI, "/", string(getMyCommands))
+	toReturn[deleteMyCommands] = fmt.Sprint(baseURI, "/", string(deleteMyCommands))
+	toReturn[exportChatInviteLink] = fmt.Sprint(baseURI, "/", string(exportChatInviteLink))
+	toReturn[createChatInviteLink] = fmt.Sprint(baseURI, "/", string(createChatInviteLink))
+	toReturn[editChatInviteLink] = fmt.Sprint(baseURI, "/", string(editChatInviteLink))
+	toReturn[revokeChatInviteLink] = fmt.Sprint(baseURI, "/", string(revokeChatInviteLink))
+	toReturn[approveChatJoinRequest] = fmt.Sprint(baseURI, "/", string(approveChatJoinRequest))
+	toReturn[declineChatJoinRequest] = fmt.Sprint(baseURI, "/", string(declineChatJoinRequest))
+	toReturn[pinChatMessage] = fmt.Sprint(baseURI, "/", string(pinChatMessage))
+	toReturn[unpinChatMessage] = fmt.Sprint(baseURI, "/", string(unpinChatMessage))
+	toReturn[unpinAllChatMessages] = fmt.Sprint(baseURI, "/", string(unpinAllChatMessages))
+	toReturn[setChatTitle] = fmt.Sprint(baseURI, "/", string(setChatTitle))
+	toReturn[setChatDescription] = fmt.Sprint(baseURI, "/", string(setChatDescription))
+	toReturn[setChatPhoto] = fmt.Sprint(baseURI, "/", string(setChatPhoto))
+	toReturn[deleteChatPhoto] = fmt.Sprint(baseURI, "/", string(deleteChatPhoto))
+	toReturn[createForumTopic] = fmt.Sprint(baseURI, "/", string(createForumTopic))
+	toReturn[editForumTopic] = fmt.Sprint(baseURI, "/", string(editForumTopic))
+	toReturn[closeForumTopic] = fmt.Sprint(baseURI, "/", string(closeForumTopic))
+	toReturn[reopenForumTopic] = fmt.Sprint(baseURI, "/", string(reopenForumTopic))
+	toReturn[deleteForumTopic] = fmt.Sprint(baseURI, "/", string(deleteForumTopic))
+	toReturn[copyMessage] = fmt.Sprint(baseURI, "/", string(copyMessage))
+	toReturn[forwardMessages] = fmt.Sprint(baseURI, "/", string(forwardMessages))
+	toReturn[copyMessages] = fmt.Sprint(baseURI, "/", string(copyMessages))
+	toReturn[deleteMessages] = fmt.Sprint(baseURI, "/", string(deleteMessages))
+	toReturn[setMessageReaction] = fmt.Sprint(baseURI, "/", string(setMessageReaction))
+	toReturn[setMyName] = fmt.Sprint(baseURI, "/", string(setMyName))
+	toReturn[getMyName] = fmt.Sprint(baseURI, "/", string(getMyName))
+	toReturn[setMyDescription] = fmt.Sprint(baseURI, "/", string(setMyDescription))
+	toReturn[getMyDescription] = fmt.Sprint(baseURI, "/", string(getMyDescription))
+	toReturn[setMyShortDescription] = fmt.Sprint(baseURI, "/", string(setMyShortDescription))
+	toReturn[getMyShortDescription] = fmt.Sprint(baseURI, "/", string(getMyShortDescription))
+	toReturn[setChatMenuButton] = fmt.Sprint(baseURI, "/", string(setChatMenuButton))
+	toReturn[getChatMenuButton] = fmt.Sprint(baseURI, "/", string(getChatMenuButton))
+	toReturn[setMyDefaultAdministratorRights] = fmt.Sprint(baseURI, "/", string(setMyDefaultAdministratorRights))
+	toReturn[getMyDefaultAdministratorRights] = fmt.Sprint(baseURI, "/", string(getMyDefaultAdministratorRights))
+	toReturn[answerWebAppQuery] = fmt.Sprint(baseURI, "/", string(answerWebAppQuery))
+	toReturn[answerCallbackQuery] = fmt.Sprint(baseURI, "/", string(answerCallbackQuery))
 	return toReturn
 }
@@ -0,0 +1,133 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"sort"
+	"sync"
+)
+
+// A StarLedger records payments in Telegram Stars and reconciles them against the bots Star transactions.
+// It is safe for concurrent use.
+type StarLedger struct {
+	api      *TelegramBotAPI
+	payments map[string]model.SuccessfulPayment // by Telegram payment charge ID
+	mu       sync.Mutex
+}
+
+// A StarReconciliation is the result of reconciling recorded payments against the bots Star transactions.
+// Payments are sorted by their Telegram payment charge ID, transactions by date.
+type StarReconciliation struct {
+	Confirmed  []model.SuccessfulPayment // payments with a matching incoming transaction
+	Mismatched []model.SuccessfulPayment // payments with an incoming transaction of a different amount
+	Missing    []model.SuccessfulPayment // payments without an incoming transaction
+	Refunded   []model.SuccessfulPayment // payments that were refunded
+	Unrecorded []model.StarTransaction   // incoming transactions from users without a recorded payment
+}
+
+// NewStarLedger creates a new, empty StarLedger
+func NewStarLedger(api *TelegramBotAPI) *StarLedger {
+	return &StarLedger{
+		api:      api,
+		payments: map[string]model.SuccessfulPayment{},
+	}
+}
+
+// Record records a successful payment.
+// It returns false, and does not record the payment, if the payment was not made in Telegram Stars.
+func (l *StarLedger) Record(payment model.SuccessfulPayment) bool {
+	if payment.Currency != model.CurrencyStars {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.payments[payment.TelegramPaymentChargeID] = payment
+	return true
+}
+
+// RecordMessage records the successful payment contained in the message, if any.
+// It returns whether a payment in Telegram Stars was recorded.
+func (l *StarLedger) RecordMessage(m *model.Message) bool {
+	if m.SuccessfulPayment == nil {
+		return false
+	}
+	return l.Record(*m.SuccessfulPayment)
+}
+
+// Reconcile retrieves all Star transactions of the bot and matches them against the recorded payments
+func (l *StarLedger) Reconcile() (*StarReconciliation, error) {
+	incoming := map[string]model.StarTransaction{}
+	refunded := map[string]bool{}
+
+	req := model.NewOutgoingStarTransactionsRequest().SetLimit(100)
+	for {
+		resp, err := l.api.GetStarTransactions(req)
+		if err != nil {
+			return nil, err
+		}
+
+		transactions := resp.StarTransactions.Transactions
+		for _, t := range transactions {
+			if t.IsIncoming() {
+				incoming[t.ID] = t
+			} else {
+				refunded[t.ID] = true
+			}
+		}
+
+		if len(transactions) < req.Limit {
+			break
+		}
+		req.SetOffset(req.Offset + len(transactions))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	toReturn := &StarReconciliation{}
+	for id, payment := range l.payments {
+		t, ok := incoming[id]
+		switch {
+		case refunded[id]:
+			toReturn.Refunded = append(toReturn.Refunded, payment)
+		case !ok:
+			toReturn.Missing = append(toReturn.Missing, payment)
+		case t.Amount != payment.TotalAmount:
+			toReturn.Mismatched = append(toReturn.Mismatched, payment)
+		default:
+			toReturn.Confirmed = append(toReturn.Confirmed, payment)
+		}
+	}
+
+	for id, t := range incoming {
+		if _, ok := l.payments[id]; !ok && t.Source.Type == model.PartnerUser {
+			toReturn.Unrecorded = append(toReturn.Unrecorded, t)
+		}
+	}
+
+	for _, payments := range [][]model.SuccessfulPayment{toReturn.Confirmed, toReturn.Mismatched, toReturn.Missing, toReturn.Refunded} {
+		sort.Sort(byChargeID(payments))
+	}
+	sort.Sort(byDate(toReturn.Unrecorded))
+
+	return toReturn, nil
+}
+
+type byChargeID []model.SuccessfulPayment
+
+func (a byChargeID) Len() int      { return len(a) }
+func (a byChargeID) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byChargeID) Less(i, j int) bool {
+	return a[i].TelegramPaymentChargeID < a[j].TelegramPaymentChargeID
+}
+
+type byDate []model.StarTransaction
+
+func (a byDate) Len() int      { return len(a) }
+func (a byDate) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byDate) Less(i, j int) bool {
+	if a[i].Date != a[j].Date {
+		return a[i].Date < a[j].Date
+	}
+	return a[i].ID < a[j].ID
+}
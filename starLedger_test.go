@@ -0,0 +1,65 @@
+package tbotapi
+
+import (
+	"bitbucket.org/mrd0ll4r/tbotapi/model"
+	"reflect"
+	"testing"
+)
+
+func TestStarLedgerReconcile(t *testing.T) {
+	f := newFakeBot(t)
+	user := map[string]interface{}{"type": "user", "user": map[string]interface{}{"id": fakeUserID, "is_bot": false, "first_name": "Buyer"}}
+	transactions := []map[string]interface{}{
+		{"id": "d", "amount": 10, "date": 4, "source": user},
+		{"id": "z", "amount": 5, "date": 3, "source": user},
+		{"id": "b", "amount": 10, "date": 1, "source": user},
+		{"id": "y", "amount": 5, "date": 2, "source": user},
+		{"id": "c", "amount": 99, "date": 2, "source": user},
+		{"id": "x", "amount": 5, "date": 2, "source": user},
+		{"id": "r", "amount": 10, "date": 5, "receiver": user},
+	}
+	f.handle("getStarTransactions", func(params map[string]interface{}) interface{} {
+		return map[string]interface{}{"transactions": transactions}
+	})
+	api := f.connect()
+
+	l := NewStarLedger(api)
+	for _, id := range []string{"m2", "d", "r", "c", "b", "m1"} {
+		l.Record(model.SuccessfulPayment{Currency: model.CurrencyStars, TotalAmount: 10, TelegramPaymentChargeID: id})
+	}
+	if l.Record(model.SuccessfulPayment{Currency: "EUR", TotalAmount: 10, TelegramPaymentChargeID: "e"}) {
+		t.Error("recorded a payment not made in Telegram Stars")
+	}
+
+	// the result is the same no matter the order in which payments and transactions are iterated
+	for i := 0; i < 10; i++ {
+		r, err := l.Reconcile()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		checkChargeIDs(t, "confirmed", r.Confirmed, "b", "d")
+		checkChargeIDs(t, "mismatched", r.Mismatched, "c")
+		checkChargeIDs(t, "missing", r.Missing, "m1", "m2")
+		checkChargeIDs(t, "refunded", r.Refunded, "r")
+
+		var unrecorded []string
+		for _, tx := range r.Unrecorded {
+			unrecorded = append(unrecorded, tx.ID)
+		}
+		if want := []string{"x", "y", "z"}; !reflect.DeepEqual(unrecorded, want) {
+			t.Fatalf("unrecorded: got %v, want %v", unrecorded, want)
+		}
+	}
+}
+
+func checkChargeIDs(t *testing.T, name string, payments []model.SuccessfulPayment, want ...string) {
+	t.Helper()
+	var got []string
+	for _, p := range payments {
+		got = append(got, p.TelegramPaymentChargeID)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("%s: got %v, want %v", name, got, want)
+	}
+}
@@ -0,0 +1,7 @@
+// Package webapp contains helpers for the backends of Telegram Web Apps (Mini Apps).
+//
+// Web Apps receive the init data as a query string from the Telegram client, in window.Telegram.WebApp.initData.
+// Backends must validate it against the bot token before trusting any of its contents.
+//
+// Check bitbucket.org/mrd0ll4r/tbotapi
+package webapp
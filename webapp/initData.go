@@ -0,0 +1,189 @@
+package webapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors returned when validating init data
+var (
+	ErrBadHash    = errors.New("webapp: init data hash is missing or does not match")
+	ErrExpired    = errors.New("webapp: init data is expired")
+	ErrFuture     = errors.New("webapp: init data auth_date is in the future")
+	ErrNoAuthDate = errors.New("webapp: init data has no auth_date")
+)
+
+// maxClockSkew is how far auth_date may be in the future, to allow for clocks that are slightly off
+const maxClockSkew = time.Minute
+
+// secretKeyLabel is the key used to derive the secret key from the bot token
+var secretKeyLabel = []byte("WebAppData")
+
+// WebAppUser represents a user as seen by a Web App
+type WebAppUser struct {
+	ID              int64   `json:"id"`
+	IsBot           bool    `json:"is_bot"`
+	FirstName       string  `json:"first_name"`
+	LastName        *string `json:"last_name"`
+	Username        *string `json:"username"`
+	LanguageCode    *string `json:"language_code"`
+	IsPremium       bool    `json:"is_premium"`
+	AllowsWriteToPM bool    `json:"allows_write_to_pm"` // whether the bot is allowed to message the user
+	PhotoURL        *string `json:"photo_url"`          // URL of the profile photo of the user, .jpeg or .svg
+}
+
+// WebAppChat represents a chat as seen by a Web App
+type WebAppChat struct {
+	ID       int64   `json:"id"`
+	Type     string  `json:"type"` // type of the chat, "group", "supergroup" or "channel"
+	Title    string  `json:"title"`
+	Username *string `json:"username"`
+	PhotoURL *string `json:"photo_url"`
+}
+
+// WebAppInitData represents the data passed to a Web App when it is opened
+type WebAppInitData struct {
+	QueryID      string      // ID of the Web App session, for AnswerWebAppQuery
+	User         *WebAppUser // the current user
+	Receiver     *WebAppUser // the chat partner of the current user, for Web Apps opened from the attachment menu
+	Chat         *WebAppChat // the chat the Web App was opened from via the attachment menu
+	ChatType     string      // type of the chat the Web App was opened from
+	ChatInstance string      // global identifier of the chat the Web App was opened from
+	StartParam   string      // the startapp parameter of the link the Web App was opened with
+	CanSendAfter int         // time after which a message can be sent via AnswerWebAppQuery, in seconds
+	AuthDate     int64       // timestamp when the Web App was opened
+	Hash         string      // signature of all other fields
+}
+
+// AuthTime returns the time the Web App was opened
+func (d *WebAppInitData) AuthTime() time.Time {
+	return time.Unix(d.AuthDate, 0)
+}
+
+// Parse parses init data without validating it.
+// Use ParseValidated for data received from clients.
+func Parse(initData string) (*WebAppInitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf("webapp: malformed init data: %s", err)
+	}
+
+	toReturn := &WebAppInitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		Hash:         values.Get("hash"),
+	}
+
+	for key, target := range map[string]interface{}{
+		"user":     &toReturn.User,
+		"receiver": &toReturn.Receiver,
+		"chat":     &toReturn.Chat,
+	} {
+		if raw := values.Get(key); raw != "" {
+			if err = json.Unmarshal([]byte(raw), target); err != nil {
+				return nil, fmt.Errorf("webapp: malformed %s in init data: %s", key, err)
+			}
+		}
+	}
+
+	if raw := values.Get("auth_date"); raw != "" {
+		if toReturn.AuthDate, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			return nil, fmt.Errorf("webapp: malformed auth_date in init data: %s", err)
+		}
+	}
+	if raw := values.Get("can_send_after"); raw != "" {
+		if toReturn.CanSendAfter, err = strconv.Atoi(raw); err != nil {
+			return nil, fmt.Errorf("webapp: malformed can_send_after in init data: %s", err)
+		}
+	}
+
+	return toReturn, nil
+}
+
+// Validate checks that the init data was signed for the bot with the token and, if maxAge is not 0, that the Web
+// App was opened at most maxAge ago.
+// A maxAge of 0 turns the age check off, which allows replaying init data forever. Use it only if the age is checked
+// elsewhere. An auth_date more than a minute in the future is always rejected with ErrFuture.
+// Init data without a hash or with a duplicated field is rejected with ErrBadHash, as its signature is ambiguous.
+func Validate(initData, botToken string, maxAge time.Duration) error {
+	return validate(initData, botToken, maxAge, time.Now())
+}
+
+// ParseValidated validates the init data, see Validate, and parses it
+func ParseValidated(initData, botToken string, maxAge time.Duration) (*WebAppInitData, error) {
+	if err := Validate(initData, botToken, maxAge); err != nil {
+		return nil, err
+	}
+	return Parse(initData)
+}
+
+func validate(initData, botToken string, maxAge time.Duration, now time.Time) error {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return fmt.Errorf("webapp: malformed init data: %s", err)
+	}
+
+	for _, vals := range values {
+		if len(vals) > 1 {
+			return ErrBadHash
+		}
+	}
+
+	hash, err := hex.DecodeString(values.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return ErrBadHash
+	}
+	if !hmac.Equal(hash, sign(dataCheckString(values), botToken)) {
+		return ErrBadHash
+	}
+
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		if maxAge == 0 {
+			return nil
+		}
+		return ErrNoAuthDate
+	}
+	age := now.Sub(time.Unix(authDate, 0))
+	if age < -maxClockSkew {
+		return ErrFuture
+	}
+	if maxAge != 0 && age > maxAge {
+		return ErrExpired
+	}
+	return nil
+}
+
+// dataCheckString returns all fields except the hash as sorted key=value lines
+func dataCheckString(values url.Values) string {
+	lines := make([]string, 0, len(values))
+	for key, vals := range values {
+		if key == "hash" || len(vals) == 0 {
+			continue
+		}
+		lines = append(lines, key+"="+vals[0])
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// sign returns the HMAC-SHA256 signature of the data check string, keyed with the secret derived from the bot token
+func sign(dataCheckString, botToken string) []byte {
+	secret := hmac.New(sha256.New, secretKeyLabel)
+	secret.Write([]byte(botToken))
+
+	mac := hmac.New(sha256.New, secret.Sum(nil))
+	mac.Write([]byte(dataCheckString))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,90 @@
+package webapp
+
+import (
+	"encoding/hex"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const (
+	testToken = "123:ABC"
+	testUser  = `{"id":279058397,"first_name":"Vlad","username":"v","is_premium":true}`
+	testHash  = "ab643cfc9811ac144d7bc9e9eae7870099ca5480f9f93625c86613810d567ae3"
+)
+
+// testAuthTime is the auth_date of the test init data
+var testAuthTime = time.Unix(1700000000, 0)
+
+// testInitData returns the signed test init data, with the fields changed by set
+func testInitData(set func(values url.Values)) string {
+	values := url.Values{
+		"auth_date": {"1700000000"},
+		"query_id":  {"AAH"},
+		"user":      {testUser},
+		"hash":      {testHash},
+	}
+	if set != nil {
+		set(values)
+	}
+	return values.Encode()
+}
+
+func TestValidateKnownAnswer(t *testing.T) {
+	if err := validate(testInitData(nil), testToken, 0, testAuthTime); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := Parse(testInitData(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.QueryID != "AAH" || d.User == nil || d.User.ID != 279058397 || !d.User.IsPremium || !d.AuthTime().Equal(testAuthTime) {
+		t.Errorf("unexpected init data %+v", d)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		set    func(values url.Values)
+		token  string
+		maxAge time.Duration
+		now    time.Time
+		want   error
+	}{
+		{"valid", nil, testToken, time.Hour, testAuthTime.Add(time.Minute), nil},
+		{"valid without max age", nil, testToken, 0, testAuthTime.Add(24 * time.Hour), nil},
+		{"other bot", nil, "456:DEF", 0, testAuthTime, ErrBadHash},
+		{"tampered field", func(v url.Values) { v.Set("user", `{"id":1,"first_name":"Vlad"}`) }, testToken, 0, testAuthTime, ErrBadHash},
+		{"added field", func(v url.Values) { v.Set("start_param", "x") }, testToken, 0, testAuthTime, ErrBadHash},
+		{"missing hash", func(v url.Values) { v.Del("hash") }, testToken, 0, testAuthTime, ErrBadHash},
+		{"malformed hash", func(v url.Values) { v.Set("hash", "xyz") }, testToken, 0, testAuthTime, ErrBadHash},
+		{"duplicated field", func(v url.Values) { v.Add("query_id", "AAH") }, testToken, 0, testAuthTime, ErrBadHash},
+		{"duplicated hash", func(v url.Values) { v.Add("hash", testHash) }, testToken, 0, testAuthTime, ErrBadHash},
+		{"expired", nil, testToken, time.Hour, testAuthTime.Add(2 * time.Hour), ErrExpired},
+		{"slightly in the future", nil, testToken, time.Hour, testAuthTime.Add(-30 * time.Second), nil},
+		{"in the future", nil, testToken, time.Hour, testAuthTime.Add(-2 * time.Minute), ErrFuture},
+		{"in the future without max age", nil, testToken, 0, testAuthTime.Add(-2 * time.Minute), ErrFuture},
+		{"removed auth_date", func(v url.Values) { v.Del("auth_date") }, testToken, time.Hour, testAuthTime, ErrBadHash},
+	}
+
+	for _, tt := range tests {
+		if err := validate(testInitData(tt.set), tt.token, tt.maxAge, tt.now); err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+// TestValidateNoAuthDate checks init data signed without an auth_date, which only passes if the age is not checked
+func TestValidateNoAuthDate(t *testing.T) {
+	values := url.Values{"query_id": {"AAH"}, "user": {testUser}}
+	values.Set("hash", hex.EncodeToString(sign(dataCheckString(values), testToken)))
+
+	if err := validate(values.Encode(), testToken, 0, testAuthTime); err != nil {
+		t.Errorf("got %v without max age", err)
+	}
+	if err := validate(values.Encode(), testToken, time.Hour, testAuthTime); err != ErrNoAuthDate {
+		t.Errorf("got %v, want %v", err, ErrNoAuthDate)
+	}
+}